@@ -0,0 +1,258 @@
+package game
+
+import "math/rand/v2"
+
+// Contestants who like each other this much are allies, and this little are
+// rivals.
+const (
+	allyThreshold  = 3
+	rivalThreshold = -3
+)
+
+// Affinity is how two contestants feel about each other. The graph is
+// symmetric: a's feelings for b are always b's feelings for a.
+func (state *GameState) Affinity(a, b string) int {
+	return state.Affinities[a][b]
+}
+
+// bond moves how two contestants feel about each other.
+func (state *GameState) bond(a, b string, delta int) {
+	if a == b {
+		return
+	}
+	if state.Affinities == nil {
+		state.Affinities = make(map[string]map[string]int)
+	}
+	for _, pair := range [][2]string{{a, b}, {b, a}} {
+		if state.Affinities[pair[0]] == nil {
+			state.Affinities[pair[0]] = make(map[string]int)
+		}
+		state.Affinities[pair[0]][pair[1]] += delta
+	}
+}
+
+// Allies lists the contestants still in the house who are allied with name,
+// closest first.
+func (state *GameState) Allies(name string) []string {
+	return state.bonded(name, func(a int) bool { return a >= allyThreshold })
+}
+
+// Rivals lists the contestants still in the house who can't stand name,
+// bitterest first.
+func (state *GameState) Rivals(name string) []string {
+	return state.bonded(name, func(a int) bool { return a <= rivalThreshold })
+}
+
+func (state *GameState) bonded(name string, match func(int) bool) []string {
+	var names []string
+	for _, c := range state.Contestants {
+		if c.Name != name && match(state.Affinity(name, c.Name)) {
+			names = append(names, c.Name)
+		}
+	}
+	// strongest feelings first, keeping house order for ties
+	for i := 1; i < len(names); i++ {
+		for j := i; j > 0 && abs(state.Affinity(name, names[j])) > abs(state.Affinity(name, names[j-1])); j-- {
+			names[j], names[j-1] = names[j-1], names[j]
+		}
+	}
+	return names
+}
+
+// plural picks the verb form that agrees with a list of names.
+func plural(names []string, one, many string) string {
+	if len(names) == 1 {
+		return one
+	}
+	return many
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// seedAffinity gives every pair of contestants a first impression of each
+// other. Contestants with the same personality hit it off.
+func seedAffinity(rng *rand.Rand, state *GameState) {
+	state.Affinities = make(map[string]map[string]int)
+	for i, a := range state.Contestants {
+		for _, b := range state.Contestants[i+1:] {
+			delta := rng.IntN(5) - 2
+			if a.Personality == b.Personality {
+				delta += 2
+			}
+			state.bond(a.Name, b.Name, delta)
+		}
+	}
+}
+
+// bondGroup brings everyone who spent the day together a little closer.
+func (state *GameState) bondGroup(names []string, delta int) {
+	for i, a := range names {
+		for _, b := range names[i+1:] {
+			state.bond(a, b, delta)
+		}
+	}
+}
+
+// allyBonus is the help a contestant gets from allies in the same group,
+// at most 2.
+func (state *GameState) allyBonus(name string, group []Character) int {
+	bonus := 0
+	for _, c := range group {
+		if c.Name != name && state.Affinity(name, c.Name) >= allyThreshold {
+			bonus++
+		}
+	}
+	return min(bonus, 2)
+}
+
+// runDrama blows up the worst relationship in the house. Allies take sides,
+// and if the player is in the middle of it, they decide how to handle it.
+// A rival of the player's always gets the cameras first.
+func (s *Season) runDrama(title string) error {
+	state := s.State
+	var a, b string
+	worst := 0
+	for i, x := range state.Contestants {
+		for _, y := range state.Contestants[i+1:] {
+			if aff := state.Affinity(x.Name, y.Name); aff < worst {
+				a, b, worst = x.Name, y.Name, aff
+			}
+		}
+	}
+	if rivals := state.Rivals(state.PlayerCharacter.Name); len(rivals) > 0 {
+		return s.playerDrama(title, rivals[0])
+	}
+	if a == "" {
+		return s.show(Event{Title: title, Text: "It's a quiet night in the house. Everyone is getting along, at least for now."})
+	}
+	if b == state.PlayerCharacter.Name {
+		a, b = b, a
+	}
+	if a == state.PlayerCharacter.Name {
+		return s.playerDrama(title, b)
+	}
+
+	state.bond(a, b, -2)
+	s.adjust(a, -1)
+	s.adjust(b, -1)
+	state.feel(a, -1, 0, 2)
+	state.feel(b, -1, 0, 2)
+	s.record(MomentDrama, a, b, highlightContestant(a)+" and "+highlightContestant(b)+" had it out in the kitchen.")
+	text := "Back at the house, " + highlightContestant(a) + " and " + highlightContestant(b) + " finally have it out in the kitchen, and the whole house hears about it."
+	sideA, sideB := s.takeSides(a, b), s.takeSides(b, a)
+	if len(sideA) > 0 {
+		text += " " + nameList(sideA) + plural(sideA, " backs ", " back ") + highlightContestant(a) + " up."
+	}
+	if len(sideB) > 0 {
+		text += " " + nameList(sideB) + plural(sideB, " sides", " side") + " with " + highlightContestant(b) + "."
+	}
+	if len(sideA)+len(sideB) == 0 {
+		text += " Nobody else wants any part of it."
+	}
+	return s.show(Event{Title: title, Text: text + s.bondsReport()})
+}
+
+// takeSides has a's allies turn on b, and returns who stood with a.
+func (s *Season) takeSides(a, b string) []string {
+	var side []string
+	for _, ally := range s.State.Allies(a) {
+		if ally == b || ally == s.State.PlayerCharacter.Name || s.State.Affinity(ally, b) >= allyThreshold {
+			continue
+		}
+		s.State.bond(ally, b, -1)
+		s.State.feel(ally, 0, 0, 1)
+		side = append(side, ally)
+	}
+	return side
+}
+
+func (s *Season) playerDrama(title, rival string) error {
+	state := s.State
+	p := state.PlayerCharacter
+	opts := []Option{
+		{"Stand your ground", "stand"},
+		{"Let it go", "letgo"},
+	}
+	allies := state.Allies(p.Name)
+	if len(allies) > 0 {
+		opts = append(opts, Option{"Get your allies to back you up", "allies"})
+	}
+	opt, err := s.choose(Prompt{
+		Title: title,
+		Text:  "Back at the house, " + highlightContestant(rival) + " corners you in the kitchen and starts telling everyone within earshot that you're not here for the right reasons.",
+	}, "What do you do?", opts...)
+	if err != nil {
+		return err
+	}
+
+	if opt != "letgo" {
+		state.feel(p.Name, 0, 0, 2)
+	}
+	state.feel(rival, 0, 0, 2)
+	var text string
+	switch opt {
+	case "stand":
+		state.bond(p.Name, rival, -2)
+		if s.check(p.Name, p.Charisma, 12) {
+			s.adjust(p.Name, 1)
+			s.adjust(rival, -2)
+			text = "You stay calm and take " + highlightContestant(rival) + " apart point by point. By the end, the house is on your side."
+		} else {
+			s.adjust(p.Name, -2)
+			text = "You raise your voice, " + highlightContestant(rival) + " raises theirs, and the cameras catch every second of it."
+		}
+	case "letgo":
+		state.bond(p.Name, rival, 2)
+		text = "You shrug it off and go back to your tea. " + highlightContestant(rival) + " runs out of steam, and later even mumbles something like an apology."
+	case "allies":
+		side := s.takeSides(p.Name, rival)
+		state.bond(p.Name, rival, -2)
+		s.adjust(rival, -2)
+		s.adjust(p.Name, -1)
+		text = nameList(side) + plural(side, " closes", " close") + " ranks around you, and " + highlightContestant(rival) + " storms out. You won, but the {Bachelor} hears the house is getting cliquey."
+		if len(side) == 0 {
+			text = "You look around for backup, but your allies suddenly find somewhere else to be. " + highlightContestant(rival) + " gets the last word, and the {Bachelor} hears all about it."
+		}
+	}
+	s.record(MomentDrama, p.Name, rival, "You and "+highlightContestant(rival)+" clashed in the kitchen.")
+	return s.show(Event{Title: title, Text: text + s.bondsReport()})
+}
+
+// bondsReport tells the player where they stand with the rest of the house.
+func (s *Season) bondsReport() string {
+	name := s.State.PlayerCharacter.Name
+	allies, rivals := s.State.Allies(name), s.State.Rivals(name)
+	return "\n\nYour allies: " + nameList(allies) + ". Your rivals: " + nameList(rivals) + "."
+}
+
+// defendBubble lets the strongest ally of the first contestant below the
+// cut vouch for them before the roses are handed out. It returns the
+// narration, or "" if nobody spoke up.
+func (s *Season) defendBubble(num int) string {
+	state := s.State
+	if num <= 0 || num >= len(state.Contestants) {
+		return ""
+	}
+	_, bottom := state.splitCeremony(num)
+	bubble := bottom[0].Name
+	going := map[string]bool{}
+	for _, c := range bottom {
+		going[c.Name] = true
+	}
+	for _, ally := range state.Allies(bubble) {
+		if ally == state.PlayerCharacter.Name || going[ally] {
+			continue // the player speaks for themselves, and the rest are busy saving themselves
+		}
+		s.adjust(ally, -1)
+		s.adjust(bubble, 3)
+		state.bond(ally, bubble, 2)
+		SortByRelationship(state)
+		return "Before the ceremony, " + highlightContestant(ally) + " pulls the {Bachelor} aside to vouch for " + s.nameOf(bubble) + "."
+	}
+	return ""
+}
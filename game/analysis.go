@@ -0,0 +1,190 @@
+package game
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Lexicons are the word lists the player's own words are read against.
+// They live in content packs, so user packs can teach the lead new words.
+type Lexicons struct {
+	Positive  []string `yaml:"positive" json:"positive"`
+	Negative  []string `yaml:"negative" json:"negative"`
+	Negations []string `yaml:"negations" json:"negations"`
+	// Creepy are words and phrases that make the lead take a step back.
+	Creepy []string `yaml:"creepy" json:"creepy"`
+	Topics []Topic  `yaml:"topics" json:"topics"`
+}
+
+// Topic is something a lead might love to talk about.
+type Topic struct {
+	Name  string   `yaml:"name" json:"name"`
+	Words []string `yaml:"words" json:"words"`
+	// Leads are pieces of lead personalities, like "cowboy" or "chef", that
+	// light up when the topic comes up.
+	Leads []string `yaml:"leads" json:"leads"`
+}
+
+// Limits on how much the player should say.
+const (
+	minWords = 3
+	maxWords = 40
+	// quoteLen is how much of what the player said gets quoted back.
+	quoteLen = 60
+)
+
+// Analysis is a reading of something the player said to the lead.
+type Analysis struct {
+	Text  string
+	Words int
+	// Question is whether the player actually asked something.
+	Question bool
+	// Sentiment is positive words minus negative ones, flipped after a
+	// negation like "not".
+	Sentiment int
+	// Creepy is the phrase that crossed the line, if one did.
+	Creepy string
+	// Shouting is whether the player typed in all caps or piled on
+	// exclamation marks.
+	Shouting bool
+	// Topics are the topics brought up, and Hit is the word that brought
+	// up the first one the lead loves, if any.
+	Topics []string
+	Hit    string
+	// Score is how the lead takes it, about -6 to 6.
+	Score int
+}
+
+// tokens splits text into lowercase words, keeping apostrophes.
+func tokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '\''
+	})
+}
+
+// matchWord reports whether a token is a lexicon word. Longer words also
+// match their endings, so "cook" matches "cooking".
+func matchWord(token, word string) bool {
+	word = strings.ToLower(strings.TrimSpace(word))
+	if token == word {
+		return true
+	}
+	return len(word) >= 4 && strings.HasPrefix(token, word)
+}
+
+func matchAny(token string, words []string) (string, bool) {
+	for _, w := range words {
+		if matchWord(token, w) {
+			return w, true
+		}
+	}
+	return "", false
+}
+
+// Analyze reads what the player said to a lead.
+func Analyze(text string, lead Character) Analysis {
+	lex := content.Lexicons
+	text = strings.TrimSpace(text)
+	words := tokens(text)
+	a := Analysis{Text: text, Words: len(words)}
+
+	a.Question = strings.Contains(text, "?")
+	if !a.Question && len(words) > 0 {
+		switch words[0] {
+		case "who", "what", "when", "where", "why", "how", "do", "does", "did", "are", "is", "would", "will", "can", "could", "have", "should":
+			a.Question = true
+		}
+	}
+
+	letters, upper := 0, 0
+	for _, r := range text {
+		if unicode.IsLetter(r) {
+			letters++
+			if unicode.IsUpper(r) {
+				upper++
+			}
+		}
+	}
+	a.Shouting = (letters >= 8 && upper == letters) || strings.Count(text, "!") >= 3
+
+	joined := " " + strings.Join(words, " ") + " "
+	for _, phrase := range lex.Creepy {
+		p := " " + strings.Join(tokens(phrase), " ") + " "
+		if strings.TrimSpace(p) != "" && strings.Contains(joined, p) {
+			a.Creepy = phrase
+			break
+		}
+	}
+
+	for i, t := range words {
+		sign := 0
+		if _, ok := matchAny(t, lex.Positive); ok {
+			sign = 1
+		} else if _, ok := matchAny(t, lex.Negative); ok {
+			sign = -1
+		}
+		if sign == 0 {
+			continue
+		}
+		for j := max(i-2, 0); j < i; j++ {
+			if _, ok := matchAny(words[j], lex.Negations); ok {
+				sign = -sign
+				break
+			}
+		}
+		a.Sentiment += sign
+	}
+
+	personality := strings.ToLower(lead.Personality)
+	loved := 0
+	for _, topic := range lex.Topics {
+		var hit string
+		for _, t := range words {
+			if _, ok := matchAny(t, topic.Words); ok {
+				hit = t
+				break
+			}
+		}
+		if hit == "" {
+			continue
+		}
+		a.Topics = append(a.Topics, topic.Name)
+		for _, l := range topic.Leads {
+			if l = strings.ToLower(strings.TrimSpace(l)); l != "" && strings.Contains(personality, l) {
+				if a.Hit == "" {
+					a.Hit = hit
+				}
+				loved++
+				break
+			}
+		}
+	}
+
+	switch {
+	case a.Words < minWords:
+		a.Score -= 2
+	case a.Words > maxWords:
+		a.Score--
+	}
+	if a.Question {
+		a.Score++
+	}
+	a.Score += min(max(a.Sentiment, -2), 2)
+	a.Score += min(loved*2, 4)
+	if a.Shouting {
+		a.Score--
+	}
+	if a.Creepy != "" {
+		a.Score -= 4
+	}
+	return a
+}
+
+// Quote is what the player said, trimmed for quoting back.
+func (a Analysis) Quote() string {
+	q := a.Text
+	if r := []rune(q); len(r) > quoteLen {
+		q = strings.TrimSpace(string(r[:quoteLen])) + "..."
+	}
+	return q
+}
@@ -0,0 +1,70 @@
+package game
+
+const aquariumTitle = "2. New England Aquarium"
+
+// tankChallenges are the ways to stand out on the aquarium group date, one
+// per stat.
+var tankChallenges = []struct {
+	stat, label, win, lose string
+}{
+	{"strength", "Volunteer to dive in the Giant Ocean Tank",
+		"You suit up and swim a lap with the sea turtles while the whole group watches through the glass. When you climb out, {he} {is} waiting with a towel.",
+		"You make it about four feet down before your ears give out. The sea turtles are unimpressed."},
+	{"charisma", "Narrate the penguin feeding for the crowd",
+		"You grab the microphone and have a crowd of school kids in stitches. The {Bachelor} can't stop laughing either.",
+		"You take the microphone, freeze, and end up reading penguin facts off the placard."},
+	{"attractiveness", "Pose for the photo shoot in front of the jellyfish",
+		"The blue light from the jellyfish tank does wonders for you. The photographer asks the {Bachelor} to step in for a couple's shot.",
+		"The jellyfish steal the show. Nobody looks at the photos of you."},
+}
+
+// runTankDate is the group date for everyone but the contestant on the
+// one-on-one. The rest of the group play to their strengths.
+func (s *Season) runTankDate(dated string) error {
+	state := s.State
+	p := state.PlayerCharacter
+	b := highlightBachelor(state.Bachelor.Name)
+
+	var group []Character
+	for _, c := range state.Contestants {
+		if c.Name != dated && !c.IsPlayer {
+			group = append(group, c)
+		}
+	}
+	best := func(c Character) int { return max(c.Charisma, c.Attractiveness, c.Strength) }
+	standouts := s.groupRolls(group, best, 12)
+
+	var text string
+	if dated == p.Name {
+		text = "While you were out, the rest of the house had the group date at the aquarium."
+	} else {
+		opts := make([]Option, len(tankChallenges))
+		for i, ch := range tankChallenges {
+			opts[i] = Option{ch.label, ch.stat}
+		}
+		stat, err := s.choose(Prompt{
+			Title: aquariumTitle,
+			Text:  b + " meets the group in front of the Giant Ocean Tank. The aquarium staff have a few ways for you to get involved, and {he}'ll be watching every one of them.",
+		}, "How do you stand out?", opts...)
+		if err != nil {
+			return err
+		}
+		for _, ch := range tankChallenges {
+			if ch.stat != stat {
+				continue
+			}
+			if s.check(p.Name, statValue(p, stat), 12) {
+				s.adjust(p.Name, 3)
+				state.feel(p.Name, 1, -2, 0)
+				text = ch.win
+			} else {
+				state.feel(p.Name, -1, -2, 0)
+				text = ch.lose
+			}
+		}
+	}
+	if len(standouts) > 0 {
+		text += "\n\n" + nameList(standouts) + plural(standouts, " also catches", " also catch") + " the {Bachelor}'s eye among the tanks."
+	}
+	return s.show(Event{Title: aquariumTitle, Text: text})
+}
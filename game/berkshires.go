@@ -0,0 +1,250 @@
+package game
+
+const berkshiresTitle = "3. The Berkshires"
+
+// runTwoOnOne takes the two contestants at the bottom of the house on a date
+// with the lead. Only one of them comes back; the other is sent home on the
+// spot. The player is on it if they're near the bottom.
+func (s *Season) runTwoOnOne() error {
+	state := s.State
+	if len(state.Contestants) < 4 {
+		return nil
+	}
+	SortByRelationship(state)
+	var pair []Character
+	for i := len(state.Contestants) - 1; i >= 0 && len(pair) < 2; i-- {
+		c := state.Contestants[i]
+		if state.RoseOf(c.Name) == RoseNone {
+			pair = append(pair, c)
+		}
+	}
+	if len(pair) < 2 {
+		return nil
+	}
+	for i, c := range state.Contestants {
+		// anyone in the bottom three is close enough to the edge
+		if c.IsPlayer && i >= len(state.Contestants)-3 && state.RoseOf(c.Name) == RoseNone && !pair[0].IsPlayer && !pair[1].IsPlayer {
+			pair[1] = c
+		}
+	}
+	a, b := pair[0], pair[1]
+	if b.IsPlayer {
+		a, b = b, a
+	}
+	lead := highlightBachelor(state.Bachelor.Name)
+
+	intro := "A two-on-one date card arrives for " + s.nameOf(a.Name) + " and " + s.nameOf(b.Name) + ". Everyone knows what that means: only one of them is coming back."
+	if a.IsPlayer {
+		opt, err := s.choose(Prompt{
+			Title: berkshiresTitle,
+			Text:  intro + "\n\nYou, " + highlightContestant(b.Name) + " and " + lead + " sit down to a picnic in a meadow, and it's the most awkward lunch of your life.",
+		}, "How do you make your case?",
+			Option{"Tell {him} why you're falling for {him}", "heart"},
+			Option{"Warn {him} about " + b.Name + "'s real intentions", "warn"},
+			Option{"Take {his} hand and let the moment speak", "moment"},
+		)
+		if err != nil {
+			return err
+		}
+		var text string
+		switch opt {
+		case "heart":
+			if s.check(a.Name, a.Charisma, 11) {
+				s.adjust(a.Name, 3)
+				text = "You say it plainly, and {he} listen{s} to every word."
+			} else {
+				text = "You trip over your words. {He} nod{s} politely."
+			}
+		case "warn":
+			state.bond(a.Name, b.Name, -3)
+			if s.check(a.Name, a.Charisma, 14) {
+				s.adjust(a.Name, 2)
+				s.adjust(b.Name, -3)
+				text = "You lay it all out, calmly, and " + highlightContestant(b.Name) + " doesn't have an answer."
+			} else {
+				s.adjust(a.Name, -2)
+				text = "It comes out as a cheap shot, and " + lead + " frowns at you."
+			}
+		case "moment":
+			if s.check(a.Name, a.Attractiveness, 12) {
+				s.adjust(a.Name, 3)
+				text = "{He} squeeze{s} your hand back and doesn't let go for the rest of the picnic."
+			} else {
+				text = "{He} gently takes {his} hand back to reach for the lemonade."
+			}
+		}
+		if err := s.show(Event{Title: berkshiresTitle, Text: text}); err != nil {
+			return err
+		}
+	} else {
+		for _, c := range pair {
+			if s.check(c.Name, c.Charisma, 12) {
+				s.adjust(c.Name, 2)
+			}
+		}
+	}
+
+	winner, loser := a, b
+	if state.Relationship[b.Name]+s.Rand.IntN(3)-1 > state.Relationship[a.Name] {
+		winner, loser = b, a
+	}
+	state.Eliminated = append(state.Eliminated, loser.Name)
+	for i, c := range state.Contestants {
+		if c.Name == loser.Name {
+			state.Contestants = append(state.Contestants[:i], state.Contestants[i+1:]...)
+			break
+		}
+	}
+	s.walkouts++
+	state.feel(winner.Name, 2, -1, -2)
+	s.record(MomentEliminated, loser.Name, winner.Name, capitalize(s.nameOf(loser.Name))+" lost the two-on-one to "+s.nameOf(winner.Name)+" and went home on the spot.")
+
+	rose := s.awardRose(RoseTwoOnOne, winner.Name)
+	if loser.IsPlayer {
+		state.Ending = EndingEliminated
+		s.pendingSave = true
+		s.end()
+		return s.show(Event{
+			Title: "The End",
+			Text:  "As the sun goes down over the meadow, " + lead + " picks up the rose and turns to " + highlightContestant(winner.Name) + ". You're sent home right there, on the spot. The walk to the car is a long one.",
+		})
+	}
+	text := "As the sun goes down over the meadow, " + lead + " picks up the rose. " + highlightContestant(loser.Name) + " is sent home on the spot, and the car is already waiting."
+	if rose != "" {
+		text += "\n\n" + rose
+	}
+	if !a.IsPlayer {
+		text = intro + "\n\n" + text
+	}
+	return s.show(Event{Title: berkshiresTitle, Text: text})
+}
+
+// wildernessChallenges are the jobs on the wilderness group date, from the
+// easiest Strength check to the hardest.
+var wildernessChallenges = []struct {
+	value, label string
+	difficulty   int
+	reward       int
+	win, lose    string
+}{
+	{"fire", "Build the campfire", 10, 2,
+		"You have a fire roaring before anyone else has found kindling. The {Bachelor} pulls up a log next to you.",
+		"The fire smokes, sputters and dies. Someone else has to relight it."},
+	{"ridge", "Lead the group up the ridge", 12, 3,
+		"You set the pace all the way to the top, and the {Bachelor} is right behind you the whole way.",
+		"Halfway up, you have to stop for a breather, and the group passes you."},
+	{"pack", "Carry the heaviest pack", 14, 4,
+		"You haul the cooler up the whole mountain without a word of complaint. The {Bachelor} can't stop talking about it.",
+		"You make it about a mile before someone has to take half the load off you."},
+}
+
+// runWilderness is the Berkshires group date: a day in the woods where
+// Strength finally matters.
+func (s *Season) runWilderness() error {
+	state := s.State
+	p := state.PlayerCharacter
+	var group []Character
+	for _, c := range state.Contestants {
+		if !c.IsPlayer {
+			group = append(group, c)
+		}
+	}
+	standouts := s.groupRolls(group, strength, 12)
+
+	opts := make([]Option, len(wildernessChallenges))
+	for i, ch := range wildernessChallenges {
+		opts[i] = Option{ch.label, ch.value}
+	}
+	opt, err := s.choose(Prompt{
+		Title: berkshiresTitle,
+		Text:  "The group date is a wilderness challenge deep in the Berkshires. Packs, a ridge to climb and a campsite to set up before dark, and " + highlightBachelor(state.Bachelor.Name) + " is keeping score.",
+	}, "What do you take on?", opts...)
+	if err != nil {
+		return err
+	}
+	var text string
+	for _, ch := range wildernessChallenges {
+		if ch.value != opt {
+			continue
+		}
+		if s.check(p.Name, p.Strength, ch.difficulty) {
+			s.adjust(p.Name, ch.reward)
+			state.feel(p.Name, 1, -2, 0)
+			text = ch.win
+		} else {
+			state.feel(p.Name, -1, -2, 1)
+			text = ch.lose
+		}
+	}
+	if len(standouts) > 0 {
+		text += "\n\n" + nameList(standouts) + plural(standouts, " also keeps", " also keep") + " up with the {Bachelor} all day."
+	}
+	return s.show(Event{Title: berkshiresTitle, Text: text})
+}
+
+// runConfessional sits the player down in front of the cameras late at night.
+// What they say makes it to the lead one way or another: revealing feelings
+// lands if the lead feels the same, and hiding them is only safe if nobody
+// in the house wants to pass it along.
+func (s *Season) runConfessional() error {
+	state := s.State
+	p := state.PlayerCharacter
+	opts := []Option{
+		{"Admit you're falling in love with the {Bachelor}", "reveal"},
+		{"Say you're still figuring out how you feel", "unsure"},
+		{"Play it cool: you're here to win", "hide"},
+	}
+	rivals := state.Rivals(p.Name)
+	if len(rivals) > 0 {
+		opts = append(opts, Option{"Vent about " + rivals[0], "vent"})
+	}
+	opt, err := s.choose(Prompt{
+		Title: berkshiresTitle,
+		Text:  "Late that night, a producer pulls you into the confessional by the fireplace. \"So,\" she says, \"how are you really feeling about the {Bachelor}?\"",
+	}, "What do you say?", opts...)
+	if err != nil {
+		return err
+	}
+
+	SortByRelationship(state)
+	rank := 0
+	for i, c := range state.Contestants {
+		if c.IsPlayer {
+			rank = i
+		}
+	}
+	mutual := rank < len(state.Contestants)/2
+
+	var text string
+	switch opt {
+	case "reveal":
+		state.setFlag("confessed")
+		s.record(MomentDate, p.Name, "", "You told the cameras you were falling in love with the {Bachelor}.")
+		if mutual {
+			s.adjust(p.Name, 3)
+			state.feel(p.Name, 2, 0, -2)
+			text = "It's out there now. The next morning, the {Bachelor} catches your eye across the breakfast table and smiles like {he} already know{s}."
+		} else {
+			s.adjust(p.Name, -1)
+			state.feel(p.Name, -1, 0, 2)
+			text = "It's out there now. When the footage gets back to the {Bachelor}, {he} seem{s} flattered, and a little cornered."
+		}
+	case "unsure":
+		state.feel(p.Name, 0, 0, -1)
+		text = "\"Honest,\" the producer says, a little disappointed. You sleep well, at least."
+	case "hide":
+		if len(rivals) > 0 {
+			s.adjust(p.Name, -3)
+			text = "Somehow, " + highlightContestant(rivals[0]) + " hears about it before breakfast, and makes sure the {Bachelor} does too."
+		} else {
+			s.adjust(p.Name, 1)
+			text = "Nobody in the house has a reason to pass it along. Keeping a little mystery works in your favor."
+		}
+	case "vent":
+		state.bond(p.Name, rivals[0], -2)
+		s.adjust(p.Name, -1)
+		s.adjust(rivals[0], -1)
+		text = "You let it all out about " + highlightContestant(rivals[0]) + ". It feels great, right up until the episode airs."
+	}
+	return s.show(Event{Title: berkshiresTitle, Text: text})
+}
@@ -0,0 +1,253 @@
+package game
+
+const capeCodTitle = "1. Cape Cod"
+
+// memberOptions offers up to n contestants from a group as choices.
+func memberOptions(group []Character, n int) []Option {
+	var opts []Option
+	for i, c := range group {
+		if i == n {
+			break
+		}
+		opts = append(opts, Option{c.Name, c.Name})
+	}
+	return opts
+}
+
+func (s *Season) runHike(group []Character) error {
+	p := s.State.PlayerCharacter
+	b := highlightBachelor(s.State.Bachelor.Name)
+	if len(group) == 0 {
+		return s.show(Event{Title: capeCodTitle, Text: "Nobody else wanted to hike, so you spend the day alone in the hills. It's peaceful, but " + b + " never even knows you were gone."})
+	}
+	companion := group[0]
+	err := s.show(Event{
+		Title: capeCodTitle,
+		Text:  "You lace up your boots and set off into the hills with " + nameList(characterNames(group)) + ". The trail is steeper than anyone expected, and rumor has it " + b + " is waiting at the lookout at the top.",
+	})
+	if err != nil {
+		return err
+	}
+
+	opt, err := s.choose(Prompt{
+		Title: capeCodTitle,
+		Text:  "Halfway up, " + highlightContestant(companion.Name) + " slips on a loose rock and twists an ankle. The rest of the group hesitates, glancing up the trail toward the lookout.",
+	}, "What do you do?",
+		Option{"Help " + companion.Name + " limp back down the trail", "carry"},
+		Option{"Keep climbing to the lookout", "climb"},
+		Option{"Stay with " + companion.Name + " and call for the medic", "stay"},
+	)
+	if err != nil {
+		return err
+	}
+
+	var text string
+	switch opt {
+	case "carry":
+		s.State.bond(p.Name, companion.Name, 3)
+		if s.check(p.Name, p.Strength, 10) {
+			s.adjust(p.Name, 3)
+			s.adjust(companion.Name, 1)
+			text = "You sling " + highlightContestant(companion.Name) + "'s arm over your shoulder and practically carry " + s.State.narrateAbout(companion.Name, "{him}") + " the whole way down. When the story reaches " + b + ", {he} call{s} you a hero in front of everyone."
+		} else {
+			s.adjust(companion.Name, 1)
+			text = "You try to help, but halfway down you both end up sitting in the dirt, out of breath. " + highlightContestant(companion.Name) + " appreciates it, even if it wasn't the rescue you had in mind."
+		}
+	case "climb":
+		s.State.bond(p.Name, companion.Name, -3)
+		if s.check(p.Name, p.Strength, 12) {
+			s.adjust(p.Name, 4)
+			s.adjust(companion.Name, -1)
+			text = "You power up the last stretch and reach the lookout first. " + b + " is waiting with a picnic blanket and a view of the whole Cape. For twenty minutes, it's just the two of you."
+		} else {
+			s.adjust(p.Name, -1)
+			text = "You push on, but the trail wins. By the time you stagger up to the lookout, " + b + " has already heard that you left " + highlightContestant(companion.Name) + " behind."
+		}
+	case "stay":
+		s.State.bond(p.Name, companion.Name, 2)
+		if s.check(p.Name, p.Charisma, 9) {
+			s.adjust(p.Name, 2)
+			s.adjust(companion.Name, 2)
+			text = "You keep " + highlightContestant(companion.Name) + " laughing until the medic arrives. The producers love it, and so does " + b + " when {he} hear{s} how kind you were."
+		} else {
+			s.adjust(companion.Name, 1)
+			text = "You wait with " + highlightContestant(companion.Name) + " in awkward silence until the medic arrives. Nobody else seems to notice."
+		}
+	}
+
+	standouts := s.groupRolls(group[1:], strength, 12)
+	if len(standouts) > 0 {
+		text += "\n\nMeanwhile, " + nameList(standouts) + " made it to the lookout and spent some time with " + b + "."
+	}
+	return s.show(Event{Title: capeCodTitle, Text: text})
+}
+
+func (s *Season) runVolleyball(group []Character) error {
+	p := s.State.PlayerCharacter
+	b := highlightBachelor(s.State.Bachelor.Name)
+	if len(group) < 3 {
+		return s.show(Event{Title: capeCodTitle, Text: "Not enough people showed up for a game, so you toss the ball around while " + b + " watches from the balcony."})
+	}
+
+	teammateName, err := s.choose(Prompt{
+		Title: capeCodTitle,
+		Text:  "A net is strung up on the sand and " + nameList(characterNames(group)) + " are already stretching. " + b + " leans over the balcony railing to watch.",
+	}, "Who do you want as your teammate?", memberOptions(group, 3)...)
+	if err != nil {
+		return err
+	}
+
+	var teammate Character
+	var opponents []Character
+	for _, c := range group {
+		if c.Name == teammateName {
+			teammate = c
+		} else if len(opponents) < 2 {
+			opponents = append(opponents, c)
+		}
+	}
+	rival := opponents[0]
+
+	opt, err := s.choose(Prompt{
+		Title: capeCodTitle,
+		Text:  "It's match point against " + nameList(characterNames(opponents)) + ". The ball floats high over the net toward your side of the court.",
+	}, "How do you play it?",
+		Option{"Dive for it", "dive"},
+		Option{"Spike it straight at " + rival.Name, "spike"},
+		Option{"Set it up for " + teammate.Name, "set"},
+	)
+	if err != nil {
+		return err
+	}
+
+	ours := p.Strength + teammate.Strength + s.Rand.IntN(10)
+	theirs := opponents[0].Strength + opponents[1].Strength + s.Rand.IntN(10)
+
+	var text string
+	switch opt {
+	case "dive":
+		if s.check(p.Name, p.Strength, 10) {
+			ours += 5
+			text = "You throw yourself across the sand and somehow keep the ball alive. " + b + " is on {his} feet cheering."
+		} else {
+			text = "You dive and come up with a mouthful of sand and no ball."
+		}
+	case "spike":
+		if s.check(p.Name, p.Strength, 12) {
+			ours += 6
+			s.adjust(rival.Name, -2)
+			s.State.bond(p.Name, rival.Name, -2)
+			text = "You spike it so hard " + highlightContestant(rival.Name) + " doesn't even flinch until it's over. " + b + " lets out a low whistle."
+		} else {
+			s.adjust(p.Name, -1)
+			text = "You wind up, swing, and send the ball sailing into the dunes. " + highlightContestant(rival.Name) + " smirks."
+		}
+	case "set":
+		ours += teammate.Strength
+		if s.check(p.Name, p.Charisma, 9) {
+			s.adjust(p.Name, 1)
+			s.adjust(teammate.Name, 1)
+			text = "You set it up perfectly and shout encouragement as " + highlightContestant(teammate.Name) + " goes for the kill. " + b + " notices what a team player you are."
+		} else {
+			text = "You set it up for " + highlightContestant(teammate.Name) + " and hope for the best."
+		}
+	}
+
+	if ours >= theirs {
+		s.adjust(p.Name, 3)
+		s.adjust(teammate.Name, 2)
+		s.State.bond(p.Name, teammate.Name, 2)
+		text += "\n\nYou and " + highlightContestant(teammate.Name) + " win the match! " + b + " jogs down from the balcony to high-five you both."
+	} else {
+		s.State.bond(p.Name, teammate.Name, 1)
+		s.State.bondGroup(characterNames(opponents), 2)
+		for _, c := range opponents {
+			s.adjust(c.Name, 2)
+		}
+		text += "\n\n" + nameList(characterNames(opponents)) + " take the match, and " + b + " comes down to congratulate them."
+	}
+
+	if len(group) > 3 {
+		standouts := s.groupRolls(group[3:], strength, 12)
+		if len(standouts) > 0 {
+			text += "\n\nOn the next court over, " + nameList(standouts) + " also put on a show."
+		}
+	}
+	return s.show(Event{Title: capeCodTitle, Text: text})
+}
+
+func (s *Season) runRelax(group []Character) error {
+	p := s.State.PlayerCharacter
+	b := highlightBachelor(s.State.Bachelor.Name)
+
+	text := "You spread your towel out on the warm sand."
+	if len(group) > 0 {
+		text += " Nearby, " + nameList(characterNames(group)) + " have claimed a row of umbrellas."
+	}
+	opts := []Option{
+		{"Work on your tan where the {Bachelor} can see", "tan"},
+		{"Take a nap", "nap"},
+	}
+	if len(group) >= 2 {
+		opts = append(opts, Option{"Join " + group[0].Name + " and " + group[1].Name + " under their umbrella", "gossip"})
+	}
+	opt, err := s.choose(Prompt{Title: capeCodTitle, Text: text}, "How do you spend the afternoon?", opts...)
+	if err != nil {
+		return err
+	}
+
+	switch opt {
+	case "tan":
+		if s.check(p.Name, p.Attractiveness, 10) {
+			s.adjust(p.Name, 2)
+			text = "It doesn't take long. " + b + " wanders down from the balcony and asks if the spot next to you is taken."
+			opt, err = s.choose(Prompt{Title: capeCodTitle, Text: text}, "What now?",
+				Option{"Race {him} into the water", "race"},
+				Option{"Keep the conversation going", "talk"},
+			)
+			if err != nil {
+				return err
+			}
+			if opt == "race" && s.check(p.Name, p.Strength, 10) {
+				s.adjust(p.Name, 2)
+				text = "You beat {him} into the waves and {he} come{s} up laughing, splashing you back. It's the most fun {he} {has} had all day."
+			} else if opt == "talk" && s.check(p.Name, p.Charisma, 10) {
+				s.adjust(p.Name, 2)
+				text = "The conversation flows so easily that a producer has to come pull {him} away."
+			} else {
+				text = "It's a nice moment, but {he's} called away before it can turn into anything more."
+			}
+		} else {
+			text = "You lie there for hours. " + b + " never comes down. You do, however, get a sunburn."
+		}
+	case "nap":
+		s.adjust(p.Name, -1)
+		text = "You wake up to the sound of everyone packing up. Apparently " + b + " came down to the beach while you were asleep."
+	case "gossip":
+		first, second := group[0], group[1]
+		if s.check(p.Name, p.Charisma, 9) {
+			s.adjust(p.Name, 1)
+			s.adjust(first.Name, 1)
+			s.adjust(second.Name, 1)
+			s.State.bondGroup([]string{p.Name, first.Name, second.Name}, 2)
+			text = "You fit right in. " + highlightContestant(first.Name) + " and " + highlightContestant(second.Name) + " fill you in on everything they've learned about " + b + ", and when {he} stop{s} by the umbrella, the three of you have {him} in stitches."
+		} else {
+			s.adjust(second.Name, 1)
+			s.State.bond(p.Name, first.Name, -1)
+			s.State.bond(p.Name, second.Name, -1)
+			text = highlightContestant(first.Name) + " and " + highlightContestant(second.Name) + " go quiet when you sit down. When " + b + " stops by, " + highlightContestant(second.Name) + " does all the talking."
+		}
+	}
+
+	start := 0
+	if opt == "gossip" {
+		start = 2
+	}
+	if len(group) > start {
+		standouts := s.groupRolls(group[start:], attractiveness, 12)
+		if len(standouts) > 0 {
+			text += "\n\nBy the end of the afternoon, " + nameList(standouts) + " also managed to catch " + b + "'s eye."
+		}
+	}
+	return s.show(Event{Title: capeCodTitle, Text: text})
+}
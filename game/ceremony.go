@@ -0,0 +1,179 @@
+package game
+
+import (
+	"slices"
+	"strings"
+)
+
+// Ceremony is the running order of a rose ceremony, for front-ends that
+// play it out name by name. The Event carrying it still has the full
+// leaderboard and a text summary for front-ends that don't.
+type Ceremony struct {
+	// Lead is the lead's name, colored for display.
+	Lead string
+	// Intro is what happened before the first name was called.
+	Intro string
+	// Safe are the contestants who already hold a rose.
+	Safe []Standing
+	// Called are the names called, in order, before the final rose.
+	Called []Standing
+	// FinalRose goes to one of the last two contestants standing, and
+	// FinalLoser goes home. Both are empty when nobody is cut.
+	FinalRose, FinalLoser Standing
+	// Exits are the eliminated contestants' goodbyes in the limo.
+	Exits []Exit
+}
+
+// Exit is an eliminated contestant's last words.
+type Exit struct {
+	Name     string
+	IsPlayer bool
+	Quote    string
+}
+
+// beforeCeremony gives the player a last chance before the roses are handed
+// out: wait it out, plead their case to the lead for a chance of being saved
+// if it comes down to them, ask the lead what they're looking for, or leave
+// the show. It reports whether the player left.
+func (s *Season) beforeCeremony(title string) (bool, error) {
+	state := s.State
+	p := state.PlayerCharacter
+	text := "The night is winding down, and the roses are already lined up on the mantel. " + highlightBachelor(state.Bachelor.Name) + " will be back any minute."
+	if known := state.knownTastes(); len(known) > 0 {
+		text += "\n\nWhat you know about " + highlightBachelor(state.Bachelor.Name) + " so far:\n" + strings.Join(known, "\n")
+	}
+	a, err := s.ask(Prompt{
+		Clear: true,
+		Title: title,
+		Text:  text,
+		Fields: []Field{{
+			Key:   "ceremony",
+			Title: "What do you do?",
+			Options: []Option{
+				{"Wait for the ceremony", "wait"},
+				{"Pull the {Bachelor} aside and plead your case", "plead"},
+				{"Ask the {Bachelor} what {he's} looking for", "ask"},
+				{"Tell the {Bachelor} you're leaving the show", "leave"},
+			},
+		}},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	switch a["ceremony"] {
+	case "ask":
+		return false, s.askLead(title)
+	case "plead":
+		s.pleaded = true
+		return false, s.show(Event{
+			Title: title,
+			Text:  "You find " + highlightBachelor(state.Bachelor.Name) + " by the fireplace and tell {him} exactly why you're still here. {He} listen{s} to every word, but {his} face gives nothing away. If it comes down to you tonight, you'll find out whether it was enough.",
+		})
+	case "leave":
+		state.Eliminated = append(state.Eliminated, p.Name)
+		for i, c := range state.Contestants {
+			if c.IsPlayer {
+				state.Contestants = append(state.Contestants[:i], state.Contestants[i+1:]...)
+				break
+			}
+		}
+		state.Ending = EndingWalkedAway
+		s.record(MomentWalkout, p.Name, "", "You told the {Bachelor} you weren't feeling it and left on your own terms.")
+		s.pendingSave = true
+		s.end()
+		return true, s.show(Event{
+			Title: "The End",
+			Text:  "You pull " + highlightBachelor(state.Bachelor.Name) + " aside and tell {him} the truth: you're just not feeling it. {He} walk{s} you out to the limo, and the rest of the house watches through the window as you drive away on your own terms.",
+		})
+	}
+	return false, nil
+}
+
+// pleadSave gives a player who pleaded their case and ended up on the
+// bubble, one of the first two in line to go home, a last chance: if the
+// lead was swayed, the player takes the last rose from the lowest contestant
+// staying, who goes home in their place. It swaps the two in top and bottom
+// and returns how it went, for the ceremony's intro.
+func (s *Season) pleadSave(top, bottom []Character) string {
+	state := s.State
+	p := state.PlayerCharacter
+	at := slices.IndexFunc(bottom, func(c Character) bool { return c.IsPlayer })
+	if at < 0 || at > 1 {
+		return ""
+	}
+	last := -1
+	for i := len(top) - 1; i >= 0; i-- {
+		if state.RoseOf(top[i].Name) == RoseNone {
+			last = i
+			break
+		}
+	}
+	if last < 0 {
+		return ""
+	}
+	if !s.check(p.Name, p.Charisma, 12) {
+		return "With one rose left, " + highlightBachelor(state.Bachelor.Name) + " glances your way, and you can tell {he's} thinking about the fireplace. It isn't enough."
+	}
+	rival := top[last]
+	top[last] = bottom[at]
+	// whoever loses the last rose is the first to go
+	copy(bottom[1:at+1], bottom[:at])
+	bottom[0] = rival
+	s.record(MomentRose, p.Name, rival.Name, "Your plea by the fireplace won you the last rose over "+highlightContestant(rival.Name)+".")
+	return "With one rose left, " + highlightBachelor(state.Bachelor.Name) + " looks at " + highlightContestant(rival.Name) + " for a long moment, then at you. Whatever you said by the fireplace, it worked."
+}
+
+// buildCeremony orders a ceremony for the front-ends. Rose holders are
+// announced first, then names are called from the lead's favourite down,
+// and the final rose is called last, with the lowest contestant staying
+// and the highest contestant going left standing side by side.
+func (s *Season) buildCeremony(top, bottom []Character, intro string) *Ceremony {
+	state := s.State
+	cer := &Ceremony{Lead: highlightBachelor(state.Bachelor.Name), Intro: state.narrate(intro)}
+
+	var called []Standing
+	for i, c := range top {
+		st := Standing{Rank: i + 1, Name: c.Name, IsPlayer: c.IsPlayer, Status: StandingRose, Rose: state.RoseOf(c.Name)}
+		if st.Rose != RoseNone {
+			cer.Safe = append(cer.Safe, st)
+		} else {
+			called = append(called, st)
+		}
+	}
+	if len(called) > 0 && len(bottom) > 0 {
+		cer.FinalRose = called[len(called)-1]
+		cer.FinalLoser = Standing{Rank: len(top) + 1, Name: bottom[0].Name, IsPlayer: bottom[0].IsPlayer, Status: StandingEliminated}
+		called = called[:len(called)-1]
+	}
+	cer.Called = called
+
+	// nobody in the same limo line says the same thing, until the quotes
+	// run out
+	quotes := s.Rand.Perm(len(content.ExitQuotes))
+	for i, c := range bottom {
+		exit := Exit{Name: c.Name, IsPlayer: c.IsPlayer}
+		if !c.IsPlayer {
+			exit.Quote = state.narrate(content.ExitQuotes[quotes[i%len(quotes)]])
+		}
+		cer.Exits = append(cer.Exits, exit)
+	}
+	return cer
+}
+
+// Summary is the ceremony told as plain text.
+func (cer *Ceremony) Summary() string {
+	var b strings.Builder
+	if cer.Intro != "" {
+		b.WriteString(cer.Intro + "\n\n")
+	}
+	if cer.FinalRose.Name != "" {
+		b.WriteString("With one rose left, it came down to " + HighlightStanding(cer.FinalRose) + " and " + HighlightStanding(cer.FinalLoser) + ". The final rose went to " + HighlightStanding(cer.FinalRose) + ".\n")
+	}
+	for _, e := range cer.Exits {
+		if e.Quote != "" {
+			b.WriteString("\n" + highlightEliminated(e.Name) + ", in the limo: \"" + e.Quote + "\"")
+		}
+	}
+	return strings.TrimSpace(b.String())
+}
@@ -1,10 +1,9 @@
 package game
 
 import (
-	"github.com/charmbracelet/huh"
 	"fmt"
-	"math/rand"
-	"time"
+	"math/rand/v2"
+	"strconv"
 	"strings"
 )
 
@@ -18,6 +17,19 @@ type Character struct {
     Height        string
 		Personality		string
 		Noun					string
+		// Pronouns are how the narrative refers to the character.
+		Pronouns			Pronouns
+		Strategy			Strategy
+		Hometown			string
+		Family				[]FamilyMember
+		// Profile is the lead's; contestants have none.
+		Profile				Profile
+		// Mood, Energy and Stress are how a contestant is holding up, and
+		// Streak counts down a confident streak.
+		Mood					int
+		Energy				int
+		Stress				int
+		Streak				int
     IsPlayer      bool
 		IsBachelor		bool
 }
@@ -27,71 +39,122 @@ type Character struct {
 // var heights = []string{"4'9\"", "4'10\"", "4'11\"", "5'0\"", "5'1\"", "5'2\"", "5'3\"", "5'4\"", "5'5\"", "5'6\"", "5'7\"", "5'8\"", "5'9\"", "5'10\"", "5'11\"", "6'0\"", "6'1\"", "6'2\"", "6'3\""}
 // var personalities = []string{"witty", "shy", "outgoing", "competitive", "thoughtful", "adventurous"}
 
-func GenerateContestants(state *GameState) {
-	state.Contestants = GenerateRandomContestants(24, state)
+func GenerateContestants(rng *rand.Rand, state *GameState) {
+	state.Contestants = GenerateRandomContestants(rng, 24, state)
 	state.Contestants = append(state.Contestants, state.PlayerCharacter)
-	ShuffleCharacters(state.Contestants)
+	ShuffleCharacters(rng, state.Contestants)
 
 	for _, c := range state.Contestants {
 			state.Relationship[c.Name] = 0
 	}
+	seedAffinity(rng, state)
+	state.recordStats("Start of the season")
+	state.initMeters()
+
+	// a Bachelorette may be drawn from the contestant pool, so a name already
+	// in the house is swapped for a free one, from the other pool if need be
+	state.Bachelor = GenerateBachelor(rng, state.LeadTitle, state.LeadPronouns)
+	if _, taken := state.Relationship[state.Bachelor.Name]; taken {
+			pools := [][]string{content.BachelorNames, content.ContestantNames}
+			if state.LeadPronouns == SheHer {
+					pools[0], pools[1] = pools[1], pools[0]
+			}
+			for _, pool := range pools {
+					if name, ok := freeName(rng, pool, state.Relationship); ok {
+							state.Bachelor.Name = name
+							break
+					}
+			}
+	}
 
-	state.Bachelor = GenerateBachelor()
+}
 
+// freeName picks a name from pool that nobody in taken has yet.
+func freeName(rng *rand.Rand, pool []string, taken map[string]int) (string, bool) {
+	var free []string
+	for _, name := range pool {
+			if _, ok := taken[name]; !ok {
+					free = append(free, name)
+			}
+	}
+	if len(free) == 0 {
+			return "", false
+	}
+	return free[rng.IntN(len(free))], true
 }
 
-func GenerateRandomContestant(name string) Character {
+func GenerateRandomContestant(rng *rand.Rand, name string) Character {
+	personality := content.Personalities[rng.IntN(len(content.Personalities))]
 	return Character{
 			Name:          name,
-			Charisma:      rand.Intn(4) + 1,
-			Attractiveness: rand.Intn(4) + 1,
-			Strength:  rand.Intn(4) + 1,
-			EyeColor:      eyeColors[rand.Intn(len(eyeColors))],
-			HairColor:     hairColors[rand.Intn(len(hairColors))],
-			Height:        heights[rand.Intn(len(heights))],
-			Personality:		personalities[rand.Intn(len(personalities))],
-			Noun:					beautyTerms[rand.Intn(len(beautyTerms))],
+			Charisma:      rng.IntN(4) + 1,
+			Attractiveness: rng.IntN(4) + 1,
+			Strength:  rng.IntN(4) + 1,
+			EyeColor:      content.EyeColors[rng.IntN(len(content.EyeColors))],
+			HairColor:     content.HairColors[rng.IntN(len(content.HairColors))],
+			Height:        content.Heights[rng.IntN(len(content.Heights))],
+			Personality:		personality,
+			Noun:					content.BeautyTerms[rng.IntN(len(content.BeautyTerms))],
+			Pronouns:			SheHer,
+			Strategy:			StrategyFor(personality),
+			Hometown:			content.Hometowns[rng.IntN(len(content.Hometowns))],
 			IsPlayer:      false,
 			IsBachelor:			false,
 	}
 }
 
-func GenerateRandomContestants(n int, state *GameState) []Character {
-	rand.Seed(time.Now().UnixNano()) // Ensure different results each run
+func GenerateRandomContestants(rng *rand.Rand, n int, state *GameState) []Character {
 	usedNames := map[string]bool{}
 	usedNames[state.PlayerCharacter.Name] = true
 	var contestants []Character
 
 	for len(contestants) < n {
-			name := names[rand.Intn(len(names))]
+			name := content.ContestantNames[rng.IntN(len(content.ContestantNames))]
 			if usedNames[name] {
 					continue // avoid duplicates
 			}
 			usedNames[name] = true
-			contestants = append(contestants, GenerateRandomContestant(name))
+			contestants = append(contestants, GenerateRandomContestant(rng, name))
 	}
 
 	return contestants
 }
 
-func GenerateBachelor() Character {
+// GenerateBachelor rolls the season's lead. The name pool follows the lead's
+// pronouns, and the title ("Bachelor" or "Bachelorette") becomes the noun.
+func GenerateBachelor(rng *rand.Rand, title string, p Pronouns) Character {
+	if title == "" {
+		title = "Bachelor"
+	}
+	if p.Subject == "" {
+		p = HeHim
+	}
+	names := content.BachelorNames
+	switch {
+	case p == SheHer:
+		names = content.ContestantNames
+	case p == TheyThem && rng.IntN(2) == 0:
+		names = content.ContestantNames
+	}
 	return Character{
-		Name:          bachelorNames[rand.Intn(len(bachelorNames))],
-		Charisma:      rand.Intn(5) + 1,
-		Attractiveness: rand.Intn(5) + 1,
-		Strength:  rand.Intn(5) + 1,
-		EyeColor:      eyeColors[rand.Intn(len(eyeColors))],
-		HairColor:     hairColors[rand.Intn(len(hairColors))],
-		Height:        heights[rand.Intn(len(heights))],
-		Personality:		bachelorPersonalities[rand.Intn(len(bachelorPersonalities))],
-		Noun:					"bachelor",
+		Name:          names[rng.IntN(len(names))],
+		Charisma:      rng.IntN(5) + 1,
+		Attractiveness: rng.IntN(5) + 1,
+		Strength:  rng.IntN(5) + 1,
+		EyeColor:      content.EyeColors[rng.IntN(len(content.EyeColors))],
+		HairColor:     content.HairColors[rng.IntN(len(content.HairColors))],
+		Height:        content.Heights[rng.IntN(len(content.Heights))],
+		Personality:		content.BachelorPersonalities[rng.IntN(len(content.BachelorPersonalities))],
+		Noun:					strings.ToLower(title),
+		Pronouns:			p,
 		IsPlayer:      false,
 		IsBachelor:			true,
+		Profile:			GenerateProfile(rng),
 }
 }
 
-func ShuffleCharacters(chars []Character) {
-	rand.Shuffle(len(chars), func(i, j int) {
+func ShuffleCharacters(rng *rand.Rand, chars []Character) {
+	rng.Shuffle(len(chars), func(i, j int) {
 		chars[i], chars[j] = chars[j], chars[i]
 	})
 }
@@ -126,129 +189,119 @@ func ReactToPlayerChoice(c Character, state *GameState) {
 
 	// Adjust relationship based on player stats and interaction outcome
 	if charisma > 3 {
-			state.Relationship[c.Name] += 1
+			state.react(c.Name, 1)
 	} else if attractiveness > 3 {
-			state.Relationship[c.Name] += 1
+			state.react(c.Name, 1)
 	} else {
-			state.Relationship[c.Name] -= 1
+			state.react(c.Name, -1)
 	}
 
 	// Special outcome for high charisma (maybe some *romantic* bonus points)
 	if charisma > 4 && relationshipScore >= 3 {
 			fmt.Println("Your charisma sparks some chemistry. They're blushing a little... something is growing here.")
-			state.Relationship[c.Name] += 2
+			state.react(c.Name, 2)
 	}
 }
 
 
 
-func CreatePlayerCharacter(state *GameState) {
+func (s *Season) CreatePlayerCharacter() error {
+	state := s.State
 	var c Character
 	c.IsPlayer = true
 	c.Noun = "player"
-	fmt.Print("\033[H\033[2J")
-
-	err := huh.NewForm(
-			huh.NewGroup(
-					huh.NewNote().
-            	Title("🌹 The Bachelor Simulator 🌹").
-            	Description("Enter as a contestant in the bachelor."),
 
-					huh.NewInput().
-							Title("What's your name?").
-							Placeholder("e.g. Ellory").
-							Value(&c.Name),
-			),
-	).Run()
+	a, err := s.ask(Prompt{
+		Clear: true,
+		Title: "🌹 The Bachelor Simulator 🌹",
+		Text:  "Enter as a contestant in the bachelor.",
+		Fields: []Field{
+			{Key: "name", Title: "What's your name?", Placeholder: "e.g. Ellory"},
+			{Key: "pronouns", Title: "Your pronouns", Options: PronounOptions},
+			{Key: "lead", Title: "Which show are you on?", Options: LeadTitles},
+			{Key: "leadPronouns", Title: "The lead's pronouns", Options: PronounOptions},
+		},
+	})
 	if err != nil {
-		fmt.Println("Cancelled.")
-		return
+		return err
 	}
+	c.Name = a["name"]
+	c.Pronouns = ParsePronouns(a["pronouns"])
+	state.LeadTitle = a["lead"]
+	if state.LeadTitle == "" {
+		state.LeadTitle = "Bachelor"
+	}
+	state.LeadPronouns = ParsePronouns(a["leadPronouns"])
 
+	var problem string
 	for {
-		err = huh.NewForm(
-			huh.NewGroup(
-				huh.NewNote().
-            	Title("Stats").
-            	Description("Assign a total of 9 stat points to different attributes."),
-
-				huh.NewSelect[int]().
-						Title("Charisma (1 = very low, 5 = very high)").
-						Options(intOptions(1, 5)...).
-						Value(&c.Charisma),
-	
-				huh.NewSelect[int]().
-						Title("Attractiveness").
-						Options(intOptions(1, 5)...).
-						Value(&c.Attractiveness),
-	
-				huh.NewSelect[int]().
-						Title("Strength").
-						Options(intOptions(1, 5)...).
-						Value(&c.Strength),
-			),
-		).Run()
+		a, err = s.ask(Prompt{
+			Title: "Stats",
+			Text:  problem + "Assign a total of 9 stat points to different attributes.",
+			Fields: []Field{
+				{Key: "charisma", Title: "Charisma (1 = very low, 5 = very high)", Options: intOptions(1, 5)},
+				{Key: "attractiveness", Title: "Attractiveness", Options: intOptions(1, 5)},
+				{Key: "strength", Title: "Strength", Options: intOptions(1, 5)},
+			},
+		})
 		if err != nil {
-			fmt.Println("Cancelled.")
-			return
+			return err
 		}
+		c.Charisma, _ = strconv.Atoi(a["charisma"])
+		c.Attractiveness, _ = strconv.Atoi(a["attractiveness"])
+		c.Strength, _ = strconv.Atoi(a["strength"])
 		total := c.Charisma + c.Strength + c.Attractiveness
 		if total <= 9 {
-				fmt.Print("\033[H\033[2J")
 				break
 		}
-		fmt.Printf("\n❗ Your total was %d. Please distribute at most 9 points.\n\n", total)
+		problem = fmt.Sprintf("❗ Your total was %d. Please distribute at most 9 points.\n\n", total)
 	}
-	err = huh.NewForm(
-		huh.NewGroup(
-			huh.NewNote().
-            	Title("Attributes"),
-
-			// huh.NewSelect[string]().
-			// 			Title("Personality").
-			// 			Options(func() []huh.Option[string] {
-			// 				opts := make([]huh.Option[string], 0, len(personalities))
-			// 				for _, p := range personalities {
-			// 						opts = append(opts, huh.NewOption(capitalize(p), p))
-			// 				}
-			// 				return opts
-			// 			}()...).
-			// 			Value(&c.Personality),
-
-				huh.NewInput().
-							Title("Personality").
-							Placeholder("e.g. contemplative").
-							Value(&c.Personality),
-
-					huh.NewInput().
-						Title("Eye Color").
-						Placeholder("e.g. brown").
-						Value(&c.EyeColor),
-
-					huh.NewInput().
-							Title("Hair Color").
-							Placeholder("e.g. black").
-							Value(&c.HairColor),
-
-					huh.NewInput().
-							Title("Height").
-							Placeholder("e.g. 5'11").
-							Value(&c.Height),
-		),
-	).Run()
+	a, err = s.ask(Prompt{
+		Clear: true,
+		Title: "Attributes",
+		Fields: []Field{
+			{Key: "personality", Title: "Personality", Placeholder: "e.g. contemplative"},
+			{Key: "eyes", Title: "Eye Color", Placeholder: "e.g. brown"},
+			{Key: "hair", Title: "Hair Color", Placeholder: "e.g. black"},
+			{Key: "height", Title: "Height", Placeholder: "e.g. 5'11"},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	c.Personality = a["personality"]
+	c.EyeColor = a["eyes"]
+	c.HairColor = a["hair"]
+	c.Height = a["height"]
+
+	a, err = s.ask(Prompt{
+		Clear: true,
+		Title: "Family",
+		Text:  "If you make it to hometowns, the {Bachelor} will meet your family.",
+		Fields: []Field{
+			{Key: "hometown", Title: "Where are you from?", Placeholder: "e.g. Worcester, Massachusetts"},
+			{Key: "family", Title: "Who's waiting at home?", Options: FamilySizes},
+			{Key: "temperament", Title: "How do they feel about reality TV?", Options: append(append([]Option(nil), Temperaments...), Option{"Surprise me", ""})},
+		},
+	})
 	if err != nil {
-		fmt.Println("Cancelled.")
-		return
+		return err
 	}
+	c.Hometown = a["hometown"]
+	if c.Hometown == "" {
+		c.Hometown = content.Hometowns[s.Rand.IntN(len(content.Hometowns))]
+	}
+	c.Family = GenerateFamily(s.Rand, a["family"], a["temperament"])
 	state.Relationship[c.Name] = 0
 
 	state.PlayerCharacter = c
+	return nil
 }
 
-func intOptions(min, max int) []huh.Option[int] {
-	var opts []huh.Option[int]
+func intOptions(min, max int) []Option {
+	var opts []Option
 	for i := min; i <= max; i++ {
-			opts = append(opts, huh.NewOption(fmt.Sprintf("%d", i), i))
+			opts = append(opts, Option{strconv.Itoa(i), strconv.Itoa(i)})
 	}
 	return opts
 }
@@ -259,300 +312,3 @@ func capitalize(s string) string {
 	}
 	return strings.ToUpper(s[:1]) + s[1:]
 }
-
-var heights = []string{"4'9\"", "4'10\"", "4'11\"", "5'0\"", "5'1\"", "5'2\"", "5'3\"", "5'4\"", "5'5\"", "5'6\"", "5'7\"", "5'8\"", "5'9\"", "5'10\"", "5'11\"", "6'0\"", "6'1\"", "6'2\"", "6'3\""}
-
-var names = []string{
-	"Aaliyah",
-	"Adelina",
-	"Alexis",
-	"Alice",
-	"Alyssa",
-	"Amara",
-	"Angelina",
-	"Anastasia",
-	"Ariana",
-	"Autumn",
-	"Bailey",
-	"Barbara",
-	"Beatrice",
-	"Bianca",
-	"Blake",
-	"Brenda",
-	"Brooklyn",
-	"Camila",
-	"Carmen",
-	"Carolina",
-	"Catherine",
-	"Chloe",
-	"Claire",
-	"Clara",
-	"Danica",
-	"Delilah",
-	"Diana",
-	"Donna",
-	"Elena",
-	"Elizabeth",
-	"Ellie",
-	"Ellery",
-	"Ellory",
-	"Emily",
-	"Emma",
-	"Erica",
-	"Evelyn",
-	"Fiona",
-	"Gabriella",
-	"Grace",
-	"Hannah",
-	"Harper",
-	"Heather",
-	"Isabella",
-	"Jade",
-	"Jasmine",
-	"Jessica",
-	"Jordan",
-	"Julia",
-	"Kaitlyn",
-	"Kate",
-	"Katherine",
-	"Kendall",
-	"Kimberly",
-	"Kylie",
-	"Lauren",
-	"Leah",
-	"Lexi",
-	"Libby",
-	"Lily",
-	"Lola",
-	"Madeline",
-	"Maya",
-	"Megan",
-	"Melanie",
-	"Monica",
-	"Naomi",
-	"Natalie",
-	"Olivia",
-	"Paige",
-	"Penelope",
-	"Rachel",
-	"Reagan",
-	"Riley",
-	"Rose",
-	"Sadie",
-	"Samantha",
-	"Sara",
-	"Sasha",
-	"Scarlett",
-	"Selena",
-	"Sophie",
-	"Stella",
-	"Summer",
-	"Taylor",
-	"Victoria",
-	"Violet",
-	"Vivian",
-	"Zoe",
-	"Ashley",
-	"Jordan",
-	"Karlie",
-	"Kai",
-	"Riley",
-	"Skylar",
-	"Taylor",
-	"Morgan",
-	"Cameron",
-	"Jesse",
-	"Avery",
-	"Adriana",
-	"Bailey",
-	"Brooke",
-	"Charlotte",
-	"Caitlyn",
-	"Destiny",
-	"Faith",
-	"Gracie",
-	"Kaitlin",
-	"Lindsey",
-	"Madison",
-	"Maria",
-	"Paige",
-	"Riley",
-	"Skylar",
-	"Taylor",
-	"Valeria",
-	"Viviana",
-}
-var personalities = []string{
-	"romantic",
-	"bubbly",
-	"shy",
-	"confident",
-	"jealous",
-	"loyal",
-	"ambitious",
-	"outgoing",
-	"awkward",
-	"cynical",
-	"dramatic",
-	"sweet",
-	"quirky",
-	"intense",
-	"flirty",
-	"bold",
-	"funny",
-	"serious",
-	"competitive",
-	"chill",
-	"mysterious",
-	"emotional",
-	"honest",
-	"playful",
-	"reserved",
-	"thoughtful",
-	"adventurous",
-	"stylish",
-	"sensitive",
-	"charismatic",
-}
-var hairColors = []string{
-	"blonde",
-	"brunette",
-	"black",
-	"red",
-	"auburn",
-	"platinum",
-	"chestnut",
-	"dirty blonde",
-	"silver",
-	"pink",
-}
-var eyeColors = []string{
-	"blue",
-	"green",
-	"brown",
-	"hazel",
-	"gray",
-	"amber",
-	"dark brown",
-	"black",
-}
-var beautyTerms = []string{
-	"stunner",
-	"angel",
-	"babe",
-	"bombshell",
-	"fox",
-	"knockout",
-	"vision",
-	"goddess",
-	"doll",
-	"queen",
-	"lady",
-	"muse",
-	"gem",
-	"enchantress",
-	"charm",
-	"diva",
-	"starlet",
-	"cutie",
-	"heartbreaker",
-	"showstopper",
-	"darling",
-	"sweetheart",
-	"vixen",
-	"icon",
-	"flame",
-	"flower",
-	"princess",
-	"pearl",
-	"treasure",
-	"dream",
-}
-
-var bachelorNames = []string{
-	"Aaron",
-	"Alex",
-	"Andrew",
-	"Austin",
-	"Blake",
-	"Brady",
-	"Brandon",
-	"Caleb",
-	"Cameron",
-	"Carter",
-	"Chase",
-	"Chris",
-	"Clayton",
-	"Cole",
-	"Connor",
-	"Cory",
-	"Daniel",
-	"David",
-	"Devin",
-	"Drew",
-	"Dylan",
-	"Eli",
-	"Eric",
-	"Ethan",
-	"Evan",
-	"Gabe",
-	"Grayson",
-	"Greg",
-	"Ian",
-	"Jack",
-	"Jacob",
-	"Jake",
-	"James",
-	"Jason",
-	"Jayden",
-	"Jesse",
-	"John",
-	"Jordan",
-	"Josh",
-	"Julian",
-	"Kyle",
-	"Leo",
-	"Liam",
-	"Logan",
-	"Lucas",
-	"Mark",
-	"Mason",
-	"Matt",
-	"Nate",
-	"Paul",
-	"Ryan",
-	"Zach",
-}
-
-var bachelorPersonalities = []string{
-	"the Gym Bro",
-	"the Sensitive Cowboy",
-	"the Spreadsheet Guy",
-	"the Golden Retriever Man",
-	"the Adrenaline Junkie",
-	"the Overly Chill Surfer",
-	"the Finance Bro",
-	"the Guy Who Brings A Guitar",
-	"the Emotional Himbo",
-	"the One-Upper",
-	"the Jealous Protector",
-	"the Accidental Poet",
-	"the Quiet Heartthrob",
-	"the Drama Magnet",
-	"the Guy With A Podcast",
-	"the Wholesome Flirt",
-	"the Conspiracy Theorist",
-	"the Crypto Enthusiast",
-	"the Wannabe Chef",
-	"the Outdoorsy Loner",
-	"the Motivational Speaker",
-	"the Guy Who Peaked In High School",
-	"the Armchair Philosopher",
-	"the Loud Hugger",
-	"the Deep-Feelings Dude",
-	"the Guy Who Says 'No Worries' Too Much",
-	"the Competitive Cuddler",
-	"the Guy Who Makes Everything A Metaphor",
-	"the Guy With Too Many Rings",
-	"the One Who Can't Stop Talking About His Mom",
-}
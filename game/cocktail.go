@@ -0,0 +1,244 @@
+package game
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// partySlots is how many conversations the lead has time for at a cocktail
+// party.
+const partySlots = 4
+
+// partyTopics are the ways the player can spend their time with the lead,
+// one per stat.
+var partyTopics = []struct {
+	stat, label, win, lose string
+}{
+	{"charisma", "Make {him} laugh",
+		"{He} laugh{s} so hard {he} spill{s} {his} drink, and {he} {doesn't} care.",
+		"The joke lands somewhere near the floor. {He} smile{s} politely."},
+	{"attractiveness", "Flirt",
+		"{He} lean{s} in closer with every sentence. The cameras get all of it.",
+		"{He} laugh{s} it off and steer{s} the conversation toward the weather."},
+	{"strength", "Challenge {him} to an arm-wrestle",
+		"You win, barely, and {he} demand{s} a rematch before the night is over.",
+		"{He} pin{s} your arm in about two seconds and won't stop teasing you about it."},
+}
+
+// partyQueue lines up the house to talk to the lead, the keenest and the
+// lead's favorites first.
+func (s *Season) partyQueue() []string {
+	state := s.State
+	eager := map[string]int{}
+	var queue []string
+	for _, c := range state.Contestants {
+		eager[c.Name] = state.Relationship[c.Name] + s.Rand.IntN(4)
+		if c.Strategy == StrategyWallflower {
+			eager[c.Name] -= 2
+		}
+		queue = append(queue, c.Name)
+	}
+	slices.SortStableFunc(queue, func(a, b string) int { return eager[b] - eager[a] })
+	return queue
+}
+
+// npcConversation is an NPC's time with the lead, played to whatever the
+// lead likes best.
+func (s *Season) npcConversation(name string) bool {
+	c, ok := s.State.character(name)
+	if !ok {
+		return false
+	}
+	if s.check(name, statValue(c, s.State.Bachelor.Profile.favoriteStat()), 12) {
+		s.adjust(name, 2)
+		return true
+	}
+	return false
+}
+
+// runCocktailParty is the last stretch before a ceremony. The lead only has
+// time for a few conversations, and the house lines up to have one. The
+// player can wait their turn or cut in on whoever has the lead; cutting in
+// gets them time sooner, at the expense of whoever they interrupted, who
+// won't forget it. The schemers in line don't always wait either.
+func (s *Season) runCocktailParty(title string) error {
+	state := s.State
+	p := state.PlayerCharacter
+	queue := s.partyQueue()
+	var lines []string
+	talked := false
+	steals := 0
+
+	for slot := 0; slot < partySlots && len(queue) > 0; slot++ {
+		head := queue[0]
+		if head == p.Name {
+			queue = queue[1:]
+			talked = true
+			text, err := s.playerConversation(title, lines, "It's finally your turn with the {Bachelor}.")
+			if err != nil {
+				return err
+			}
+			lines = []string{text}
+			continue
+		}
+
+		if !talked {
+			opt, err := s.choose(Prompt{
+				Title: title,
+				Text:  strings.Join(append(lines, s.partyStatus(queue, partySlots-slot)), "\n\n"),
+			}, "What do you do?",
+				Option{"Steal the {Bachelor} from " + head, "steal"},
+				Option{"Wait your turn", "wait"},
+			)
+			if err != nil {
+				return err
+			}
+			lines = nil
+			if opt == "steal" {
+				text, stole, err := s.interrupt(title, head, steals)
+				steals++
+				if err != nil {
+					return err
+				}
+				if stole {
+					queue = slices.DeleteFunc(queue[1:], func(n string) bool { return n == p.Name })
+					talked = true
+					lines = []string{text}
+					continue
+				}
+				lines = append(lines, text)
+			}
+		}
+
+		// someone further back might not wait their turn either
+		who := highlightContestant(head)
+		if i := slices.IndexFunc(queue[1:], func(n string) bool {
+			c, _ := state.character(n)
+			return c.Strategy == StrategySchemer && !c.IsPlayer
+		}); i >= 0 && s.Rand.IntN(3) == 0 {
+			thief := queue[1+i]
+			state.bond(thief, head, -2)
+			s.adjust(head, -1)
+			state.feel(head, -1, 0, 1)
+			queue = slices.Delete(queue, 1+i, 2+i)
+			s.record(MomentSteal, thief, head, highlightContestant(thief)+" cut in on "+who+" at the cocktail party.")
+			line := highlightContestant(thief) + " cuts in on " + who + " before " + state.narrateAbout(head, "{he's} finished {his}") + " first sentence."
+			if s.npcConversation(thief) {
+				line += " It works."
+			}
+			lines = append(lines, line)
+		} else if s.npcConversation(head) {
+			lines = append(lines, who+" has the {Bachelor} laughing the whole time.")
+		} else {
+			lines = append(lines, who+" gets a few minutes with the {Bachelor}, but it's nothing special.")
+		}
+		queue = queue[1:]
+	}
+
+	if !talked {
+		s.adjust(p.Name, -1)
+		state.feel(p.Name, -1, 0, 2)
+		lines = append(lines, "You wait, and wait. By the time the {Bachelor} is free, a producer is calling everyone in for the ceremony.")
+	}
+	return s.show(Event{Title: title, Text: strings.Join(lines, "\n\n")})
+}
+
+// partyStatus describes who has the lead and where the player is in line.
+func (s *Season) partyStatus(queue []string, left int) string {
+	text := highlightContestant(queue[0]) + " has the {Bachelor}'s attention."
+	if left == 1 {
+		text += " There's time for one more conversation before the ceremony."
+	} else {
+		text += " There's time for " + strconv.Itoa(left) + " more conversations before the ceremony."
+	}
+	for i, n := range queue {
+		if n == s.State.PlayerCharacter.Name {
+			text += " You're " + ordinal(i) + " in line."
+			return text
+		}
+	}
+	return text
+}
+
+// ordinal is a place in line, counting whoever is talking as zero.
+func ordinal(n int) string {
+	switch n {
+	case 1:
+		return "next"
+	case 2:
+		return "second"
+	case 3:
+		return "third"
+	}
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return strconv.Itoa(n) + suffix
+}
+
+// interrupt has the player cut in on target. Every attempt after the first
+// is harder to pull off, since the lead starts to notice. It reports
+// whether the player got the lead to themselves.
+func (s *Season) interrupt(title, target string, steals int) (string, bool, error) {
+	state := s.State
+	p := state.PlayerCharacter
+	who := highlightContestant(target)
+	state.bond(p.Name, target, -2)
+	if containsFold(state.Allies(p.Name), target) {
+		// stealing from a friend stings more
+		state.bond(p.Name, target, -2)
+	}
+	grudge := ""
+	if containsFold(state.Rivals(p.Name), target) {
+		grudge = " " + who + " is not going to forget this."
+	}
+	if !s.check(p.Name, p.Charisma, 11+2*steals) {
+		s.adjust(p.Name, -1)
+		return "You cut in on " + who + ", but the {Bachelor} asks for five more minutes with " + state.narrateAbout(target, "{him}") + ". You wait by the door, and everyone sees it." + grudge, false, nil
+	}
+	s.adjust(target, -1)
+	state.feel(target, -1, 0, 1)
+	s.record(MomentSteal, p.Name, target, "You stole the {Bachelor} away from "+who+" at the cocktail party.")
+	text, err := s.playerConversation(title, nil, "\"Mind if I steal {him} for a second?\" "+who+" forces a smile as you lead the {Bachelor} away."+grudge)
+	return text, true, err
+}
+
+// playerConversation is the player's time with the lead, resolved by a
+// check on whichever stat they play to.
+func (s *Season) playerConversation(title string, before []string, opening string) (string, error) {
+	state := s.State
+	p := state.PlayerCharacter
+	opts := make([]Option, len(partyTopics))
+	for i, t := range partyTopics {
+		opts[i] = Option{t.label, t.stat}
+	}
+	stat, err := s.choose(Prompt{
+		Title: title,
+		Text:  strings.Join(append(before, opening), "\n\n"),
+	}, "How do you spend your time?", opts...)
+	if err != nil {
+		return "", err
+	}
+	for _, t := range partyTopics {
+		if t.stat != stat {
+			continue
+		}
+		if s.check(p.Name, statValue(p, stat), 12) {
+			s.adjust(p.Name, 3)
+			state.feel(p.Name, 1, 0, -1)
+			return t.win, nil
+		}
+		state.feel(p.Name, -1, 0, 1)
+		return t.lose, nil
+	}
+	return "", nil
+}
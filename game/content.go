@@ -0,0 +1,327 @@
+package game
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed content/default.yaml
+var defaultPack []byte
+
+// Pack is a bundle of writable content: the pools characters are drawn from,
+// the word lists the player's words are read against, and the dialogues the
+// player talks through, one-on-one dates among them. The built-in pack is
+// embedded from content/default.yaml; user packs in YAML or JSON add to it,
+// or replace whole lists when Replace is set.
+type Pack struct {
+	Name    string `yaml:"name" json:"name"`
+	Replace bool   `yaml:"replace" json:"replace"`
+
+	ContestantNames       []string   `yaml:"contestant_names" json:"contestant_names"`
+	Personalities         []string   `yaml:"personalities" json:"personalities"`
+	HairColors            []string   `yaml:"hair_colors" json:"hair_colors"`
+	EyeColors             []string   `yaml:"eye_colors" json:"eye_colors"`
+	Heights               []string   `yaml:"heights" json:"heights"`
+	BeautyTerms           []string   `yaml:"beauty_terms" json:"beauty_terms"`
+	BachelorNames         []string   `yaml:"bachelor_names" json:"bachelor_names"`
+	BachelorPersonalities []string   `yaml:"bachelor_personalities" json:"bachelor_personalities"`
+	ExitQuotes            []string   `yaml:"exit_quotes" json:"exit_quotes"`
+	Hometowns             []string   `yaml:"hometowns" json:"hometowns"`
+	Lexicons              Lexicons   `yaml:"lexicons" json:"lexicons"`
+	Dialogues             []Dialogue `yaml:"dialogues" json:"dialogues"`
+}
+
+// Stats are the stat names content may refer to.
+var Stats = []string{"charisma", "attractiveness", "strength"}
+
+// minContestantNames is enough unique names for 24 contestants, the player,
+// and a lead drawn from the same pool.
+const minContestantNames = 26
+
+var content = mustParseDefault()
+
+func mustParseDefault() *Pack {
+	p, err := ParsePack("default.yaml", defaultPack)
+	if err != nil {
+		panic(err)
+	}
+	if err := p.validateComplete(); err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// CurrentContent is the content seasons are drawing from.
+func CurrentContent() *Pack {
+	return content
+}
+
+// ParsePack reads a pack, choosing JSON or YAML by the file extension.
+// Unknown fields and invalid entries are reported with their location.
+func ParsePack(name string, data []byte) (*Pack, error) {
+	var p Pack
+	if strings.EqualFold(filepath.Ext(name), ".json") {
+		dec := json.NewDecoder(bytes.NewReader(data))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&p); err != nil {
+			return nil, fmt.Errorf("pack %s: %w", name, err)
+		}
+	} else {
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		dec.KnownFields(true)
+		if err := dec.Decode(&p); err != nil {
+			return nil, fmt.Errorf("pack %s: %w", name, err)
+		}
+	}
+	if err := p.Validate(); err != nil {
+		return nil, fmt.Errorf("pack %s:\n%w", name, err)
+	}
+	return &p, nil
+}
+
+// LoadPack reads a pack file.
+func LoadPack(path string) (*Pack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePack(path, data)
+}
+
+// Validate checks every entry of a pack, reporting all problems at once.
+func (p *Pack) Validate() error {
+	var errs []error
+	lists := []struct {
+		field string
+		items []string
+	}{
+		{"contestant_names", p.ContestantNames},
+		{"personalities", p.Personalities},
+		{"hair_colors", p.HairColors},
+		{"eye_colors", p.EyeColors},
+		{"heights", p.Heights},
+		{"beauty_terms", p.BeautyTerms},
+		{"bachelor_names", p.BachelorNames},
+		{"bachelor_personalities", p.BachelorPersonalities},
+		{"exit_quotes", p.ExitQuotes},
+		{"hometowns", p.Hometowns},
+		{"lexicons.positive", p.Lexicons.Positive},
+		{"lexicons.negative", p.Lexicons.Negative},
+		{"lexicons.negations", p.Lexicons.Negations},
+		{"lexicons.creepy", p.Lexicons.Creepy},
+	}
+	for _, l := range lists {
+		for i, item := range l.items {
+			if strings.TrimSpace(item) == "" {
+				errs = append(errs, fmt.Errorf("  %s[%d]: must not be empty", l.field, i))
+			}
+		}
+	}
+
+	for i, t := range p.Lexicons.Topics {
+		if strings.TrimSpace(t.Name) == "" {
+			errs = append(errs, fmt.Errorf("  lexicons.topics[%d].name: must not be empty", i))
+		}
+		if len(t.Words) == 0 {
+			errs = append(errs, fmt.Errorf("  lexicons.topics[%d].words: must have at least one entry", i))
+		}
+		for j, w := range t.Words {
+			if strings.TrimSpace(w) == "" {
+				errs = append(errs, fmt.Errorf("  lexicons.topics[%d].words[%d]: must not be empty", i, j))
+			}
+		}
+	}
+
+	ids := map[string]bool{}
+	for i, d := range p.Dialogues {
+		if ids[d.ID] {
+			errs = append(errs, fmt.Errorf("  dialogues[%d].id: %q is used twice", i, d.ID))
+		}
+		ids[d.ID] = true
+		errs = append(errs, d.validate(fmt.Sprintf("dialogues[%d]", i))...)
+	}
+	return errors.Join(errs...)
+}
+
+// validateComplete checks that merged content can actually run a season.
+func (p *Pack) validateComplete() error {
+	var errs []error
+	lists := []struct {
+		field string
+		items []string
+	}{
+		{"personalities", p.Personalities},
+		{"hair_colors", p.HairColors},
+		{"eye_colors", p.EyeColors},
+		{"heights", p.Heights},
+		{"beauty_terms", p.BeautyTerms},
+		{"bachelor_names", p.BachelorNames},
+		{"bachelor_personalities", p.BachelorPersonalities},
+		{"exit_quotes", p.ExitQuotes},
+		{"hometowns", p.Hometowns},
+		{"lexicons.positive", p.Lexicons.Positive},
+		{"lexicons.negative", p.Lexicons.Negative},
+	}
+	for _, l := range lists {
+		if len(l.items) == 0 {
+			errs = append(errs, fmt.Errorf("  %s: must have at least one entry", l.field))
+		}
+	}
+	unique := map[string]bool{}
+	for _, n := range p.ContestantNames {
+		unique[n] = true
+	}
+	if len(unique) < minContestantNames {
+		errs = append(errs, fmt.Errorf("  contestant_names: need at least %d different names, got %d", minContestantNames, len(unique)))
+	}
+	if len(p.Dates()) == 0 {
+		errs = append(errs, fmt.Errorf("  dialogues: need at least one date"))
+	}
+	for _, id := range requiredDialogues {
+		if _, ok := p.dialogue(id); !ok {
+			errs = append(errs, fmt.Errorf("  dialogues: missing %q", id))
+		}
+	}
+	// every family member's visit starts from their temperament
+	if d, ok := p.dialogue("hometown"); ok {
+		for _, t := range Temperaments {
+			if !d.has(t.Value) {
+				errs = append(errs, fmt.Errorf("  dialogues: hometown has no %q node", t.Value))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func isStat(s string) bool {
+	for _, st := range Stats {
+		if s == st {
+			return true
+		}
+	}
+	return false
+}
+
+// merge folds another pack into this one.
+func (p *Pack) merge(o *Pack) {
+	add := func(dst *[]string, src []string) {
+		if len(src) == 0 {
+			return
+		}
+		if o.Replace {
+			*dst = append([]string(nil), src...)
+			return
+		}
+		*dst = append(*dst, src...)
+	}
+	add(&p.ContestantNames, o.ContestantNames)
+	add(&p.Personalities, o.Personalities)
+	add(&p.HairColors, o.HairColors)
+	add(&p.EyeColors, o.EyeColors)
+	add(&p.Heights, o.Heights)
+	add(&p.BeautyTerms, o.BeautyTerms)
+	add(&p.BachelorNames, o.BachelorNames)
+	add(&p.BachelorPersonalities, o.BachelorPersonalities)
+	add(&p.ExitQuotes, o.ExitQuotes)
+	add(&p.Hometowns, o.Hometowns)
+	add(&p.Lexicons.Positive, o.Lexicons.Positive)
+	add(&p.Lexicons.Negative, o.Lexicons.Negative)
+	add(&p.Lexicons.Negations, o.Lexicons.Negations)
+	add(&p.Lexicons.Creepy, o.Lexicons.Creepy)
+	if len(o.Lexicons.Topics) > 0 {
+		if o.Replace {
+			p.Lexicons.Topics = nil
+		}
+		p.Lexicons.Topics = append(p.Lexicons.Topics, o.Lexicons.Topics...)
+	}
+	if o.Replace && len(o.Dialogues) > 0 {
+		p.Dialogues = nil
+	}
+	for _, d := range o.Dialogues {
+		// a dialogue with the same id rewrites the one already loaded
+		p.Dialogues = slices.DeleteFunc(p.Dialogues, func(x Dialogue) bool { return x.ID == d.ID })
+		p.Dialogues = append(p.Dialogues, d)
+	}
+}
+
+// PackDir is where user packs are picked up automatically.
+func PackDir() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "bachelor-sim", "packs"), nil
+}
+
+// packFiles lists the pack files in dir in name order.
+func packFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, e := range entries {
+		switch strings.ToLower(filepath.Ext(e.Name())) {
+		case ".yaml", ".yml", ".json":
+			if !e.IsDir() {
+				files = append(files, filepath.Join(dir, e.Name()))
+			}
+		}
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// LoadContent starts from the built-in pack, merges every pack in PackDir
+// and then the given files, and makes the result the current content.
+func LoadContent(paths ...string) error {
+	merged := mustParseDefault()
+
+	var files []string
+	if dir, err := PackDir(); err == nil {
+		found, err := packFiles(dir)
+		if err != nil {
+			return err
+		}
+		files = append(files, found...)
+	}
+	files = append(files, paths...)
+
+	for _, f := range files {
+		p, err := LoadPack(f)
+		if err != nil {
+			return err
+		}
+		merged.merge(p)
+	}
+	if err := merged.validateComplete(); err != nil {
+		return fmt.Errorf("content after loading %s:\n%w", strings.Join(files, ", "), err)
+	}
+	content = merged
+	return nil
+}
+
+// PackList collects repeated --pack flags.
+type PackList []string
+
+func (l *PackList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *PackList) Set(path string) error {
+	*l = append(*l, path)
+	return nil
+}
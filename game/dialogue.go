@@ -0,0 +1,607 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Dialogue is a conversation authored in a content pack: a set of nodes the
+// player moves through by picking answers, starting from Start or the first
+// node. A Date is one of the one-on-one dates, which both the full season
+// and quick mode pick from.
+type Dialogue struct {
+	ID    string         `yaml:"id" json:"id"`
+	Start string         `yaml:"start" json:"start"`
+	Date  bool           `yaml:"date" json:"date"`
+	Nodes []DialogueNode `yaml:"nodes" json:"nodes"`
+}
+
+// DialogueNode is one beat of a Dialogue. A node with choices asks the
+// player; one without is a line shown on its own. Either way the
+// conversation carries on to Next, unless the player's answer says
+// otherwise, and ends when there is nowhere left to go. Ask is the question
+// put to the player, "What do you say?" if it is empty.
+type DialogueNode struct {
+	ID      string           `yaml:"id" json:"id"`
+	Speaker string           `yaml:"speaker" json:"speaker"`
+	Text    string           `yaml:"text" json:"text"`
+	Ask     string           `yaml:"ask" json:"ask"`
+	Effects Effects          `yaml:"effects" json:"effects"`
+	Choices []DialogueChoice `yaml:"choices" json:"choices"`
+	Next    string           `yaml:"next" json:"next"`
+}
+
+// DialogueChoice is one answer to a DialogueNode. It is only offered when
+// every condition in When holds. With a Check, the Outcome only happens if
+// the player passes it; otherwise Fail does.
+type DialogueChoice struct {
+	Text    string      `yaml:"text" json:"text"`
+	When    []Condition `yaml:"when" json:"when"`
+	Check   *Check      `yaml:"check" json:"check"`
+	Outcome `yaml:",inline"`
+	Fail    *Outcome `yaml:"fail" json:"fail"`
+}
+
+// Outcome is what an answer leads to: a reply, its effects and where the
+// conversation goes next. Next may be "end" to finish the conversation.
+type Outcome struct {
+	Reply   string  `yaml:"reply" json:"reply"`
+	Effects Effects `yaml:"effects" json:"effects"`
+	Next    string  `yaml:"next" json:"next"`
+}
+
+// Check is a stat check the player has to pass. Who rolls it is the player
+// by default, or "lead" when the player leaves it to the lead, or "both",
+// which adds half the lead's stat to the player's.
+type Check struct {
+	Stat       string `yaml:"stat" json:"stat"`
+	Difficulty int    `yaml:"difficulty" json:"difficulty"`
+	Who        string `yaml:"who" json:"who"`
+}
+
+// Condition is something that has to be true of the player: a value
+// between Min and Max, or a flag that is set, or not set if Unset.
+type Condition struct {
+	Value string `yaml:"value" json:"value"`
+	Min   *int   `yaml:"min" json:"min"`
+	Max   *int   `yaml:"max" json:"max"`
+	Flag  string `yaml:"flag" json:"flag"`
+	Unset bool   `yaml:"unset" json:"unset"`
+}
+
+// Effects change how things stand for the player. Dealbreaker trips one of
+// the lead's dealbreakers, if they have it. Score adds to what the
+// conversation is worth to whoever plays it: how a family takes to the
+// player, or whether a date earns a rose.
+type Effects struct {
+	Relationship int    `yaml:"relationship" json:"relationship"`
+	Mood         int    `yaml:"mood" json:"mood"`
+	Energy       int    `yaml:"energy" json:"energy"`
+	Stress       int    `yaml:"stress" json:"stress"`
+	Set          string `yaml:"set" json:"set"`
+	Clear        string `yaml:"clear" json:"clear"`
+	Dealbreaker  string `yaml:"dealbreaker" json:"dealbreaker"`
+	Score        int    `yaml:"score" json:"score"`
+}
+
+// dialogueEnd is the Next that finishes a conversation early.
+const dialogueEnd = "end"
+
+// maxDialogueSteps stops a conversation that loops back on itself forever.
+const maxDialogueSteps = 100
+
+// conditionValues are what a Condition can compare.
+// The trend is how far the player's standing has moved since the first week.
+var conditionValues = []string{"charisma", "attractiveness", "strength", "relationship", "trend", "mood", "energy", "stress"}
+
+// checkers are who can roll a Check.
+var checkers = []string{"", "player", "lead", "both"}
+
+// dialogueVars are what text can interpolate, as {{name}}. The family is
+// whoever the lead is meeting on a hometown visit.
+var dialogueVars = []string{"player", "lead", "hometown", "rival", "ally", "favorite", "family"}
+
+var varPattern = regexp.MustCompile(`\{\{\s*([a-z]*)\s*\}\}`)
+
+// requiredDialogues are the dialogues the episodes play, besides the dates.
+var requiredDialogues = []string{"hometown", "meet-the-family", "fantasy-suite"}
+
+// Dates are the one-on-one dates.
+func (p *Pack) Dates() []Dialogue {
+	var out []Dialogue
+	for _, d := range p.Dialogues {
+		if d.Date {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+// has reports whether a dialogue has a node.
+func (d Dialogue) has(id string) bool {
+	for _, n := range d.Nodes {
+		if n.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// dialogue finds a dialogue by ID.
+func (p *Pack) dialogue(id string) (Dialogue, bool) {
+	for _, d := range p.Dialogues {
+		if d.ID == id {
+			return d, true
+		}
+	}
+	return Dialogue{}, false
+}
+
+// validate checks a dialogue's nodes and that every node they lead to
+// exists.
+func (d Dialogue) validate(field string) []error {
+	var errs []error
+	if strings.TrimSpace(d.ID) == "" {
+		errs = append(errs, fmt.Errorf("  %s.id: must not be empty", field))
+	}
+	if len(d.Nodes) == 0 {
+		errs = append(errs, fmt.Errorf("  %s.nodes: must have at least one entry", field))
+	}
+	ids := map[string]bool{}
+	for i, n := range d.Nodes {
+		if strings.TrimSpace(n.ID) == "" || n.ID == dialogueEnd {
+			errs = append(errs, fmt.Errorf("  %s.nodes[%d].id: %q is not a usable id", field, i, n.ID))
+		} else if ids[n.ID] {
+			errs = append(errs, fmt.Errorf("  %s.nodes[%d].id: %q is used twice", field, i, n.ID))
+		}
+		ids[n.ID] = true
+	}
+	target := func(f, next string) {
+		if next != "" && next != dialogueEnd && !ids[next] {
+			errs = append(errs, fmt.Errorf("  %s: no node %q", f, next))
+		}
+	}
+	target(field+".start", d.Start)
+
+	for i, n := range d.Nodes {
+		nf := fmt.Sprintf("%s.nodes[%d]", field, i)
+		if strings.TrimSpace(n.Text) == "" {
+			errs = append(errs, fmt.Errorf("  %s.text: must not be empty", nf))
+		}
+		errs = append(errs, validateText(nf+".speaker", n.Speaker)...)
+		errs = append(errs, validateText(nf+".text", n.Text)...)
+		errs = append(errs, validateText(nf+".ask", n.Ask)...)
+		errs = append(errs, n.Effects.validate(nf+".effects")...)
+		target(nf+".next", n.Next)
+		for j, ch := range n.Choices {
+			cf := fmt.Sprintf("%s.choices[%d]", nf, j)
+			if strings.TrimSpace(ch.Text) == "" {
+				errs = append(errs, fmt.Errorf("  %s.text: must not be empty", cf))
+			}
+			errs = append(errs, validateText(cf+".text", ch.Text)...)
+			for k, c := range ch.When {
+				errs = append(errs, c.validate(fmt.Sprintf("%s.when[%d]", cf, k))...)
+			}
+			if ch.Check != nil {
+				if !isStat(ch.Check.Stat) {
+					errs = append(errs, fmt.Errorf("  %s.check.stat: %q is not one of %s", cf, ch.Check.Stat, strings.Join(Stats, ", ")))
+				}
+				if ch.Check.Difficulty <= 0 {
+					errs = append(errs, fmt.Errorf("  %s.check.difficulty: must be positive", cf))
+				}
+				if !slices.Contains(checkers, ch.Check.Who) {
+					errs = append(errs, fmt.Errorf("  %s.check.who: %q is not one of player, lead, both", cf, ch.Check.Who))
+				}
+			}
+			errs = append(errs, validateText(cf+".reply", ch.Reply)...)
+			errs = append(errs, ch.Effects.validate(cf+".effects")...)
+			target(cf+".next", ch.Next)
+			if ch.Fail != nil {
+				if ch.Check == nil {
+					errs = append(errs, fmt.Errorf("  %s.fail: needs a check", cf))
+				}
+				errs = append(errs, validateText(cf+".fail.reply", ch.Fail.Reply)...)
+				errs = append(errs, ch.Fail.Effects.validate(cf+".fail.effects")...)
+				target(cf+".fail.next", ch.Fail.Next)
+			}
+		}
+	}
+	return errs
+}
+
+func (c Condition) validate(field string) []error {
+	var errs []error
+	switch {
+	case c.Flag != "" && c.Value != "":
+		errs = append(errs, fmt.Errorf("  %s: set either value or flag, not both", field))
+	case c.Flag != "":
+		if c.Min != nil || c.Max != nil {
+			errs = append(errs, fmt.Errorf("  %s: min and max only apply to a value", field))
+		}
+	case !slices.Contains(conditionValues, c.Value):
+		errs = append(errs, fmt.Errorf("  %s.value: %q is not one of %s", field, c.Value, strings.Join(conditionValues, ", ")))
+	case c.Min == nil && c.Max == nil:
+		errs = append(errs, fmt.Errorf("  %s: needs a min or a max", field))
+	}
+	return errs
+}
+
+func (e Effects) validate(field string) []error {
+	if e.Dealbreaker != "" && !slices.Contains(dealbreakerTraits, e.Dealbreaker) {
+		return []error{fmt.Errorf("  %s.dealbreaker: %q is not one of %s", field, e.Dealbreaker, strings.Join(dealbreakerTraits, ", "))}
+	}
+	return nil
+}
+
+// validateText checks that text only interpolates names it can.
+func validateText(field, text string) []error {
+	var errs []error
+	for _, m := range varPattern.FindAllStringSubmatch(text, -1) {
+		if !slices.Contains(dialogueVars, m[1]) {
+			errs = append(errs, fmt.Errorf("  %s: unknown {{%s}}, expected one of %s", field, m[1], strings.Join(dialogueVars, ", ")))
+		}
+	}
+	return errs
+}
+
+// DialogueHost is what a dialogue is played against: it knows how things
+// stand for the player, rolls the checks and applies the effects. A Season
+// hosts the dialogues of the full game, and quick mode hosts its own.
+type DialogueHost interface {
+	// Value is one of the conditionValues for the player.
+	Value(name string) int
+	// Flag reports whether a flag is set.
+	Flag(name string) bool
+	// Check rolls a stat check.
+	Check(c Check) bool
+	// Apply applies effects to the player and returns anything the lead
+	// reveals because of them.
+	Apply(e Effects) string
+	// Var fills in a {{name}} variable, or returns "" if it can't.
+	Var(name string) string
+}
+
+// Conversation is a dialogue being played. It moves one node at a time, so
+// a front-end can show each line and ask each question however it likes.
+type Conversation struct {
+	// Vars fill in {{name}} variables ahead of the host.
+	Vars map[string]string
+	// Score is what the player's answers have added up to so far.
+	Score int
+
+	id     string
+	host   DialogueHost
+	nodes  map[string]DialogueNode
+	node   DialogueNode
+	reveal string
+	steps  int
+	done   bool
+}
+
+// NewConversation starts a dialogue from the content pack at start, or at
+// the dialogue's own start if start is "".
+func NewConversation(id, start string, host DialogueHost) (*Conversation, error) {
+	d, ok := content.dialogue(id)
+	if !ok {
+		return nil, fmt.Errorf("no dialogue %q", id)
+	}
+	c := &Conversation{Vars: map[string]string{}, id: id, host: host, nodes: map[string]DialogueNode{}}
+	for _, n := range d.Nodes {
+		c.nodes[n.ID] = n
+	}
+	if start == "" {
+		start = d.Start
+	}
+	if start == "" {
+		start = d.Nodes[0].ID
+	}
+	if _, ok := c.nodes[start]; !ok {
+		return nil, fmt.Errorf("dialogue %q has no node %q", id, start)
+	}
+	return c, c.enter(start)
+}
+
+// enter moves to a node and applies its effects, or ends the conversation
+// if there is nowhere left to go.
+func (c *Conversation) enter(id string) error {
+	if id == "" || id == dialogueEnd {
+		c.done = true
+		return nil
+	}
+	if c.steps == maxDialogueSteps {
+		return errors.New("dialogue " + c.id + " never ends")
+	}
+	c.steps++
+	c.node = c.nodes[id]
+	c.reveal = c.apply(c.node.Effects)
+	return nil
+}
+
+func (c *Conversation) apply(e Effects) string {
+	c.Score += e.Score
+	return c.host.Apply(e)
+}
+
+// Done reports whether the conversation is over.
+func (c *Conversation) Done() bool {
+	return c.done
+}
+
+// Speaker is who is talking, if anyone.
+func (c *Conversation) Speaker() string {
+	return c.interpolate(c.node.Speaker)
+}
+
+// Text is what is said, along with anything the lead revealed on the way.
+func (c *Conversation) Text() string {
+	text := c.interpolate(c.node.Text)
+	if c.reveal != "" {
+		text += " " + c.reveal
+	}
+	return text
+}
+
+// Ask is the question the player answers by picking one of the Choices.
+func (c *Conversation) Ask() string {
+	if c.node.Ask == "" {
+		return "What do you say?"
+	}
+	return c.interpolate(c.node.Ask)
+}
+
+// Choices are the answers the player can give. With none, the node is a
+// line to show before the conversation continues.
+func (c *Conversation) Choices() []string {
+	var out []string
+	for _, ch := range c.choices() {
+		out = append(out, c.interpolate(ch.Text))
+	}
+	return out
+}
+
+func (c *Conversation) choices() []DialogueChoice {
+	var out []DialogueChoice
+	for _, ch := range c.node.Choices {
+		if c.holds(ch.When) {
+			out = append(out, ch)
+		}
+	}
+	return out
+}
+
+// Continue carries on past a line.
+func (c *Conversation) Continue() error {
+	return c.enter(c.node.Next)
+}
+
+// Choose answers with the i'th of the Choices and carries on. It returns
+// the reply, if there is one.
+func (c *Conversation) Choose(i int) (string, error) {
+	choices := c.choices()
+	if i < 0 || i >= len(choices) {
+		return "", fmt.Errorf("dialogue %s: no choice %d", c.id, i)
+	}
+	ch := choices[i]
+	out := &ch.Outcome
+	if ch.Check != nil && !c.host.Check(*ch.Check) {
+		out = ch.Fail
+	}
+	if out == nil {
+		return "", c.Continue()
+	}
+	reply := c.interpolate(out.Reply)
+	if reveal := c.apply(out.Effects); reveal != "" {
+		reply = strings.TrimSpace(reply + " " + reveal)
+	}
+	next := c.node.Next
+	if out.Next != "" {
+		next = out.Next
+	}
+	return reply, c.enter(next)
+}
+
+// holds reports whether every condition is true of the player.
+func (c *Conversation) holds(when []Condition) bool {
+	for _, w := range when {
+		if w.Flag != "" {
+			if c.host.Flag(w.Flag) == w.Unset {
+				return false
+			}
+			continue
+		}
+		v := c.host.Value(w.Value)
+		if (w.Min != nil && v < *w.Min) || (w.Max != nil && v > *w.Max) {
+			return false
+		}
+	}
+	return true
+}
+
+// interpolate fills in the {{name}} variables in dialogue text. Pronouns
+// and the show's title are left for the narration.
+func (c *Conversation) interpolate(text string) string {
+	if !strings.Contains(text, "{{") {
+		return text
+	}
+	return varPattern.ReplaceAllStringFunc(text, func(m string) string {
+		name := varPattern.FindStringSubmatch(m)[1]
+		if v := c.Vars[name]; v != "" {
+			return v
+		}
+		if v := c.host.Var(name); v != "" {
+			return v
+		}
+		return "someone"
+	})
+}
+
+// SpokenText puts a speaker's name in front of what they say.
+func SpokenText(speaker, text string) string {
+	if speaker == "" {
+		return text
+	}
+	return speaker + ": " + text
+}
+
+// RunDialogue plays a dialogue from the content pack with the player, and
+// returns what the player's answers scored.
+func (s *Season) RunDialogue(title, id string) (int, error) {
+	c, err := s.conversation(id, "")
+	if err != nil {
+		return 0, err
+	}
+	err = s.talk(Prompt{Title: title}, c)
+	return c.Score, err
+}
+
+// conversation starts a dialogue with the player.
+func (s *Season) conversation(id, start string) (*Conversation, error) {
+	return NewConversation(id, start, seasonHost{s})
+}
+
+// talk plays a conversation through to the end, under p's title. Only the
+// first thing it shows clears the screen, if p does.
+func (s *Season) talk(p Prompt, c *Conversation) error {
+	for !c.Done() {
+		choices := c.Choices()
+		if len(choices) == 0 {
+			if err := s.show(Event{Clear: p.Clear, Title: p.Title, Speaker: c.Speaker(), Text: c.Text()}); err != nil {
+				return err
+			}
+			p.Clear = false
+			if err := c.Continue(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		opts := make([]Option, len(choices))
+		for i, ch := range choices {
+			opts[i] = Option{ch, strconv.Itoa(i)}
+		}
+		picked, err := s.choose(Prompt{Clear: p.Clear, Title: p.Title, Text: SpokenText(c.Speaker(), c.Text())}, c.Ask(), opts...)
+		if err != nil {
+			return err
+		}
+		p.Clear = false
+		i, _ := strconv.Atoi(picked)
+		reply, err := c.Choose(i)
+		if err != nil {
+			return err
+		}
+		if reply != "" {
+			if err := s.show(Event{Title: p.Title, Text: reply}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// seasonHost plays dialogues against a season.
+type seasonHost struct {
+	s *Season
+}
+
+func (h seasonHost) Value(name string) int {
+	state := h.s.State
+	p := state.PlayerCharacter
+	switch name {
+	case "relationship":
+		return state.Relationship[p.Name]
+	case "trend":
+		return state.relationshipTrend(p.Name)
+	case "mood":
+		return p.Mood
+	case "energy":
+		return p.Energy
+	case "stress":
+		return p.Stress
+	}
+	return statValue(p, name)
+}
+
+func (h seasonHost) Flag(name string) bool {
+	return h.s.State.Flags[name]
+}
+
+func (h seasonHost) Check(c Check) bool {
+	s := h.s
+	p, lead := s.State.PlayerCharacter, s.State.Bachelor
+	switch c.Who {
+	case "lead":
+		return s.statCheck(statValue(lead, c.Stat), c.Difficulty)
+	case "both":
+		return s.check(p.Name, statValue(p, c.Stat)+statValue(lead, c.Stat)/2, c.Difficulty)
+	}
+	return s.check(p.Name, statValue(p, c.Stat), c.Difficulty)
+}
+
+func (h seasonHost) Apply(e Effects) string {
+	s := h.s
+	state := s.State
+	name := state.PlayerCharacter.Name
+	if e.Relationship != 0 {
+		s.adjust(name, e.Relationship)
+	}
+	if e.Mood != 0 || e.Energy != 0 || e.Stress != 0 {
+		state.feel(name, e.Mood, e.Energy, e.Stress)
+	}
+	if e.Set != "" {
+		state.setFlag(e.Set)
+	}
+	delete(state.Flags, e.Clear)
+	if e.Dealbreaker != "" {
+		return s.tripDealbreaker(name, e.Dealbreaker)
+	}
+	return ""
+}
+
+func (h seasonHost) Var(name string) string {
+	state := h.s.State
+	p := state.PlayerCharacter
+	switch name {
+	case "player":
+		return highlightPlayer(p.Name)
+	case "lead":
+		return highlightBachelor(state.Bachelor.Name)
+	case "hometown":
+		if p.Hometown != "" {
+			return p.Hometown
+		}
+		return "home"
+	case "family":
+		return "your family"
+	case "rival":
+		if rivals := state.Rivals(p.Name); len(rivals) > 0 {
+			return highlightContestant(rivals[0])
+		}
+	case "ally":
+		if allies := state.Allies(p.Name); len(allies) > 0 {
+			return highlightContestant(allies[0])
+		}
+	case "favorite":
+		best, score := "", 0
+		for _, c := range state.Contestants {
+			if !c.IsPlayer && (best == "" || state.Relationship[c.Name] > score) {
+				best, score = c.Name, state.Relationship[c.Name]
+			}
+		}
+		if best != "" {
+			return highlightContestant(best)
+		}
+	}
+	return ""
+}
+
+// setFlag sets a flag for dialogues to check.
+func (state *GameState) setFlag(flag string) {
+	if state.Flags == nil {
+		state.Flags = make(map[string]bool)
+	}
+	state.Flags[flag] = true
+}
@@ -0,0 +1,260 @@
+package game
+
+import (
+	"fmt"
+	"math/rand/v2"
+)
+
+// Phase identifies where a season currently is. A Season advances through
+// the phases in order; a phase may jump straight to PhaseEnd when the
+// player is sent home.
+type Phase int
+
+const (
+	PhaseIntro Phase = iota
+	PhaseCreateCharacter
+	PhaseMeetContestants
+	PhaseMeetBachelor
+	PhaseFirstImpression
+	PhaseCapeCod
+	PhaseAquarium
+	PhaseBerkshires
+	PhaseHometowns
+	PhaseFantasySuites
+	PhaseProposal
+	PhaseEnd
+)
+
+var phaseNames = []string{
+	"intro",
+	"create-character",
+	"meet-contestants",
+	"meet-bachelor",
+	"first-impression",
+	"cape-cod",
+	"aquarium",
+	"berkshires",
+	"hometowns",
+	"fantasy-suites",
+	"proposal",
+	"end",
+}
+
+var phaseTitles = []string{
+	"the introduction",
+	"character creation",
+	"meeting the contestants",
+	"meeting the {Bachelor}",
+	"First Impressions",
+	"Cape Cod",
+	"the New England Aquarium",
+	"the Berkshires",
+	"hometowns",
+	"Martha's Vineyard",
+	"the proposal",
+	"the end",
+}
+
+// Title is the player-facing name of the phase, with {Bachelor} left for
+// narrate to fill in.
+func (p Phase) Title() string {
+	if p < 0 || int(p) >= len(phaseTitles) {
+		return "unknown"
+	}
+	return phaseTitles[p]
+}
+
+func (p Phase) String() string {
+	if p < 0 || int(p) >= len(phaseNames) {
+		return "unknown"
+	}
+	return phaseNames[p]
+}
+
+// Event is a narrative beat produced by the engine. Front-ends render it and
+// return once the player has acknowledged it.
+type Event struct {
+	Phase     Phase
+	Title     string
+	Text      string
+	Standings []Standing
+	// Ceremony, when set, is a rose ceremony the front-end may play out
+	// name by name instead of showing Standings and Text all at once.
+	Ceremony *Ceremony
+	// Speaker, when set, is who is talking: Text is their line of dialogue.
+	Speaker string
+	// Clear asks the front-end to start a fresh screen before rendering.
+	Clear bool
+}
+
+// Option is a single selectable answer to a Field.
+type Option struct {
+	Label string
+	Value string
+}
+
+// Field is one question inside a Prompt. A Field with Options is a
+// selection; a Field without Options takes free text.
+type Field struct {
+	Key         string
+	Title       string
+	Placeholder string
+	Options     []Option
+}
+
+// Prompt is a decision the engine needs from the player.
+type Prompt struct {
+	Phase  Phase
+	Title  string
+	Text   string
+	Fields []Field
+	Clear  bool
+}
+
+// Answers holds the player's response to a Prompt keyed by Field.Key.
+type Answers map[string]string
+
+// Frontend renders engine output and collects decisions. The huh forms are
+// one implementation; anything that can answer prompts can drive a season.
+type Frontend interface {
+	Show(ev Event) error
+	Ask(p Prompt) (Answers, error)
+}
+
+// Season drives a GameState through every phase using a Frontend. Every
+// random roll in the season comes from Rand, so a seed replays it exactly.
+type Season struct {
+	State *GameState
+	UI    Frontend
+	Rand  *rand.Rand
+	// SavePath enables autosaving after every rose ceremony when set.
+	SavePath string
+
+	src         *rand.PCG
+	pendingSave bool
+	// pleaded is set when the player pleaded their case before the
+	// ceremony about to be held.
+	pleaded bool
+	// walkouts counts contestants who went home outside a ceremony since
+	// the last one; the next ceremony cuts that many fewer.
+	walkouts int
+}
+
+func NewSeason(state *GameState, ui Frontend, seed uint64) *Season {
+	state.Seed = seed
+	src := newSource(seed)
+	return &Season{State: state, UI: ui, Rand: rand.New(src), src: src}
+}
+
+func (s *Season) Done() bool {
+	return s.State.Phase >= PhaseEnd
+}
+
+// Run plays phases until the season is over or the front-end fails, then
+// shows the season's highlights and the seed so the season can be replayed.
+func (s *Season) Run() error {
+	for !s.Done() {
+		if err := s.Step(); err != nil {
+			return err
+		}
+	}
+	if err := s.clearSave(); err != nil {
+		return err
+	}
+	if err := s.showHighlights(); err != nil {
+		return err
+	}
+	return s.show(Event{
+		Title: "🌹 That's a wrap 🌹",
+		Text:  fmt.Sprintf("Season seed: %d\n\nRun with --seed %d to replay this exact season.", s.State.Seed, s.State.Seed),
+	})
+}
+
+// Step plays the current phase and advances to the next one, unless the
+// phase itself moved the season somewhere else.
+func (s *Season) Step() error {
+	phase := s.State.Phase
+
+	var err error
+	switch phase {
+	case PhaseIntro:
+		err = s.RunIntroduction()
+	case PhaseCreateCharacter:
+		err = s.CreatePlayerCharacter()
+	case PhaseMeetContestants:
+		GenerateContestants(s.Rand, s.State)
+		err = s.IntroduceContestants()
+	case PhaseMeetBachelor:
+		err = s.IntroduceBachelor()
+	case PhaseFirstImpression:
+		err = s.RunFirstImpression()
+	case PhaseCapeCod:
+		err = s.RunSession1()
+	case PhaseAquarium:
+		err = s.RunSession2()
+	case PhaseBerkshires:
+		err = s.RunSession3()
+	case PhaseHometowns:
+		err = s.RunHometowns()
+	case PhaseFantasySuites:
+		err = s.RunFantasySuites()
+	case PhaseProposal:
+		err = s.RunProposal()
+	}
+	if err != nil {
+		return err
+	}
+
+	if s.State.Phase == phase {
+		s.State.Phase++
+	}
+	if s.pendingSave {
+		s.pendingSave = false
+		return s.autosave()
+	}
+	return nil
+}
+
+// show emits an event tagged with the current phase, with the lead's
+// pronouns and title filled in.
+func (s *Season) show(ev Event) error {
+	ev.Phase = s.State.Phase
+	ev.Title = s.State.narrate(ev.Title)
+	ev.Text = s.State.narrate(ev.Text)
+	return s.UI.Show(ev)
+}
+
+// ask emits a prompt tagged with the current phase, narrated like show.
+func (s *Season) ask(p Prompt) (Answers, error) {
+	p.Phase = s.State.Phase
+	p.Title = s.State.narrate(p.Title)
+	p.Text = s.State.narrate(p.Text)
+	fields := make([]Field, len(p.Fields))
+	for i, f := range p.Fields {
+		f.Title = s.State.narrate(f.Title)
+		f.Placeholder = s.State.narrate(f.Placeholder)
+		opts := make([]Option, len(f.Options))
+		for j, o := range f.Options {
+			opts[j] = Option{s.State.narrate(o.Label), o.Value}
+		}
+		f.Options = opts
+		fields[i] = f
+	}
+	p.Fields = fields
+	return s.UI.Ask(p)
+}
+
+// choose asks a single selection question and returns the chosen value.
+func (s *Season) choose(p Prompt, question string, opts ...Option) (string, error) {
+	p.Fields = []Field{{Key: "choice", Title: question, Options: opts}}
+	a, err := s.ask(p)
+	if err != nil {
+		return "", err
+	}
+	return a["choice"], nil
+}
+
+// end finishes the season early.
+func (s *Season) end() {
+	s.State.Phase = PhaseEnd
+}
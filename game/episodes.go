@@ -1,42 +1,39 @@
 package game
 
 import (
-		"github.com/charmbracelet/huh"
-    "fmt"
-		"math/rand"
-		"os"
-		"strconv"
-		"sort"
+		"math/rand/v2"
 )
 
-func WaitForEnter(title string, desc string) {
-	_ = huh.NewForm(
-			huh.NewGroup(
-					huh.NewNote().
-            	Title(title).
-            	Description(desc),
-
-					huh.NewInput().
-							Prompt("↩︎ Press enter to continue").
-							Value(new(string)),
-			),
-	).Run()
-}
-
-func ClearScreen() {
-	fmt.Print("\033[H\033[2J")
-}
-
-
-
-
-
-
+func (s *Season) RunIntroduction() error {
+	intro := "Are you ready to compete against 24 other contestants for the heart of the {Bachelor}? In a game of personality, charm, and a little bit of luck, see if you can be the lucky contestant to find The One in beautiful Boston, Massachusetts."
+	if !HasSave(s.SavePath) {
+		return s.show(Event{
+			Clear: true,
+			Title: "🌹 The {Bachelor} Simulator 🌹",
+			Text:  intro,
+		})
+	}
 
+	label := "Continue season"
+	if saved, err := readSave(s.SavePath); err == nil {
+		label = "Continue season (" + saved.State.PlayerCharacter.Name + ", up next: " + saved.Phase.Title() + ")"
+	}
 
-func RunIntroduction(state *GameState) {
-	ClearScreen()
-	WaitForEnter("🌹 The Bachelor Simulator 🌹", "Are you ready to compete against 24 other contestants for the heart of the Bachelor? In a game of personality, charm, and a little bit of luck, see if you can be the lucky contestant to find The One in beautiful Boston, Massachusetts.")
+	opt, err := s.choose(Prompt{
+		Clear: true,
+		Title: "🌹 The {Bachelor} Simulator 🌹",
+		Text:  intro,
+	}, "A season is already in progress.",
+		Option{label, "continue"},
+		Option{"Start a new season", "new"},
+	)
+	if err != nil {
+		return err
+	}
+	if opt == "continue" {
+		return s.Load(s.SavePath)
+	}
+	return nil
 }
 
 
@@ -45,28 +42,24 @@ func RunIntroduction(state *GameState) {
 
 
 
-func IntroduceContestants(state *GameState) {
-	ClearScreen()
+func (s *Season) IntroduceContestants() error {
+	state := s.State
 	var names string
 	for _, c := range state.Contestants {
 		if c.IsPlayer {
-			names += "\033[1;36m" + c.Name + "\033[0m: " + c.Personality + ", " + c.EyeColor + "-eyed, " + c.HairColor + "-haired, " + c.Height + " " + c.Noun + ".\n"
+			names += highlightPlayer(c.Name) + ": " + c.Personality + ", " + c.EyeColor + "-eyed, " + c.HairColor + "-haired, " + c.Height + " " + c.Noun + ".\n"
 			} else {
-			names += "\033[95m" + c.Name + "\033[0m: " + c.Personality + ", " + c.EyeColor + "-eyed, " + c.HairColor + "-haired, " + c.Height + " " + c.Noun + ".\n"
+			names += highlightContestant(c.Name) + ": " + c.Personality + ", " + c.EyeColor + "-eyed, " + c.HairColor + "-haired, " + c.Height + " " + c.Noun + ".\n"
 		}
-		t := c.Attractiveness + c.Charisma + rand.Intn(3)
-		state.Relationship[c.Name] += t
+		t := state.Bachelor.Profile.chemistry(c) + s.Rand.IntN(3)
+		state.react(c.Name, t)
 	}
 
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewNote().
-				Title("Meeting the Contestants").
-				Description("Now introducing our wonderful contestants:\n\n" + names + "\n\nDo you have what it takes to win the Bachelor's love?"),
-		),
-	)
-
-	form.Run()
+	return s.show(Event{
+		Clear: true,
+		Title: "Meeting the Contestants",
+		Text:  "Now introducing our wonderful contestants:\n\n" + names + "\n\nDo you have what it takes to win the {Bachelor}'s love?",
+	})
 }
 
 
@@ -76,79 +69,102 @@ func IntroduceContestants(state *GameState) {
 
 
 
-func IntroduceBachelor(state *GameState) {
-	b := state.Bachelor
+func (s *Season) IntroduceBachelor() error {
+	b := s.State.Bachelor
 	var reaction string
 	switch b.Attractiveness {
 	case 1:
-		reaction = "The contestants seem pretty unimpressed. Do they really have to compete to win the hand of a guy like this?"
+		reaction = "The contestants seem pretty unimpressed. Do they really have to compete to win the hand of a {guy} like this?"
 	case 2:
-		reaction = "The contestants look around, hoping for someone else. He's not bad, but he's not great either. Guess he'll have to do."
+		reaction = "The contestants look around, hoping for someone else. {He's} not bad, but {he's} not great either. Guess {he}'ll have to do."
 	case 3:
 		reaction = "Not bad. The contestants finally start to look serious now that they know there is something worth competing for."
 	case 4:
-		reaction = "The contestants start smiling and try to get his attention, realizing that this will be a tough fight. He is pretty special."
+		reaction = "The contestants start smiling and try to get {his} attention, realizing that this will be a tough fight. {He} {is} pretty special."
 	case 5:
-		reaction = "Most contestants giggle nervously, except for you, as you stare directly into the soul of the Bachelor. This man might be The One."
+		reaction = "Most contestants giggle nervously, except for you, as you stare directly into the soul of the {Bachelor}. This {man} might be The One."
 
 	}
-	ClearScreen()
-	form := huh.NewForm(
-		huh.NewGroup(
-			huh.NewNote().
-				Title("Meeting the Bachelor").
-				Description("This season, our Bachelor is really something special. I introduce to you,\n\n\033[1;92m" + b.Name + " " + b.Personality + "!\033[0m\n\n" + reaction),
-		),
-	)
-
-	form.Run()
-
-	var response string
-	form = huh.NewForm(
-		huh.NewGroup(
-			huh.NewNote().
-				Description("After his initial arrival, " + b.Name + " is mingling with the contestants and getting to know them briefly. As he walks up to you, you have just a fleeting moment to ask him a question."),
-
-			huh.NewInput().
-				Title("What do you say to the Bachleor?").
-				Placeholder("e.g. hey u up?").
-				Value(&response),
-		),
-	)
+	err := s.show(Event{
+		Clear: true,
+		Title: "Meeting the {Bachelor}",
+		Text:  "This season, our {Bachelor} is really something special. I introduce to you,\n\n" + highlightBachelor(b.Name+" "+b.Personality+"!") + "\n\n" + reaction,
+	})
+	if err != nil {
+		return err
+	}
 
-	form.Run()
-	c := state.PlayerCharacter
-	t := c.Attractiveness + c.Charisma
+	answers, err := s.ask(Prompt{
+		Text: "After {his} initial arrival, " + b.Name + " is mingling with the contestants and getting to know them briefly. As {he} walk{s} up to you, you have just a fleeting moment to ask {him} a question.",
+		Fields: []Field{{
+			Key:         "question",
+			Title:       "What do you say to the {Bachelor}?",
+			Placeholder: "e.g. hey u up?",
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	c := s.State.PlayerCharacter
+	said := Analyze(answers["question"], b)
+	s.adjust(c.Name, said.Score)
+	t := c.Attractiveness + c.Charisma + said.Score
 
 	var br string
-	rn := rand.Intn(2)
+	rn := s.Rand.IntN(2)
 	if t < 4 {
-		br = "It's like he didn't even see you. You hope that he just didn't hear you, but you spoke pretty loudly. Was it too loud? Or, maybe he'll come back to talk to you . . . as you wait, you come to accept that he's not coming back to meet you."
+		br = "It's like {he} didn't even see you. You hope that {he} just didn't hear you, but you spoke pretty loudly. Was it too loud? Or, maybe {he}'ll come back to talk to you . . . as you wait, you come to accept that {he's} not coming back to meet you."
 	} else if t < 7 {
 		switch rn {
 		case 0:
-			br = "\"Ha, you're nervous,\" \033[1;92m" + b.Name + "\033[0m says. \"I like that.\""
+			br = "\"Ha, you're nervous,\" " + highlightBachelor(b.Name) + " says. \"I like that.\""
 		case 1:
-			br = "\"You really know how to ask a question that stands out from the crowd, huh,\" \033[1;92m" + b.Name + "\033[0m says. \"I look forward to getting to know you better.\""
+			br = "\"You really know how to ask a question that stands out from the crowd, huh,\" " + highlightBachelor(b.Name) + " says. \"I look forward to getting to know you better.\""
 		}
 	} else {
 		switch rn {
 		case 0:
-			br = "\"Woah, I've never thought about it like that before,\" \033[1;92m" + b.Name + "\033[0m says. He blushes and walks away, but looks back over his shoulder at you afterwards."
+			br = "\"Woah, I've never thought about it like that before,\" " + highlightBachelor(b.Name) + " says. {He} blush{es} and walk{s} away, but look{s} back over {his} shoulder at you afterwards."
 		case 1:
-			br = "\"I totally agree. I've never met someone who thinks so much like me,\" \033[1;92m" + b.Name + "\033[0m says. He goes on to meet the other contestants, but you can tell he's still thinking about you."
+			br = "\"I totally agree. I've never met someone who thinks so much like me,\" " + highlightBachelor(b.Name) + " says. {He} go{es} on to meet the other contestants, but you can tell {he's} still thinking about you."
 		}
 	}
-	form = huh.NewForm(
-		huh.NewGroup(
-			huh.NewNote().
-				Description(br),
-		),
-	)
-
-	form.Run()
+	if first := s.firstReaction(said); first != "" {
+		br = first + "\n\n" + br
+	}
+	return s.show(Event{Text: br})
 }
 
+// firstReaction quotes the player's first words to the lead back at them,
+// with how they landed.
+func (s *Season) firstReaction(said Analysis) string {
+	b := highlightBachelor(s.State.Bachelor.Name)
+	if said.Text == "" {
+		return "You open your mouth, but nothing comes out. " + b + " waits a beat, then smiles politely and moves on."
+	}
+	quote := "\"" + said.Quote() + "\""
+	switch {
+	case said.Creepy != "":
+		return quote + ", you say. " + b + " blinks. \"Did you just say '" + said.Creepy + "'?\" {He} take{s} a small step back, and a producer starts walking over."
+	case said.Shouting:
+		return quote + ", you practically yell. " + b + " laughs nervously and rubs {his} ear."
+	case said.Words < minWords:
+		return quote + ", you say, and that's it. " + b + " waits for more, but nothing else comes."
+	case said.Words > maxWords:
+		return quote + "... you keep going for a while. " + b + "'s eyes start to glaze over."
+	case said.Hit != "":
+		return quote + ", you say. " + b + "'s face lights up. \"Wait, you're into " + said.Hit + " too?\""
+	case said.Question && said.Sentiment > 0:
+		return quote + ", you ask, smiling. " + b + " seems genuinely charmed by the question."
+	case said.Question:
+		return quote + ", you ask. " + b + " thinks about it for a second."
+	case said.Sentiment < 0:
+		return quote + ", you say. " + b + " raises an eyebrow. Not exactly the opener {he} expected."
+	case said.Sentiment > 0:
+		return quote + ", you say. " + b + " grins."
+	}
+	return quote + ", you say. " + b + " nods along."
+}
 
 
 
@@ -159,104 +175,112 @@ func IntroduceBachelor(state *GameState) {
 
 
 
-func RunFirstImpression(state *GameState) {
-	ClearScreen()
-	sort.Slice(state.Contestants, func(i, j int) bool {
-		a := state.Contestants[i]
-		b := state.Contestants[j]
-		return state.Relationship[a.Name] > state.Relationship[b.Name]
-	})
 
-	form := huh.NewForm(
-    huh.NewGroup(
-        huh.NewNote().
-            Title("0. First Impressions").
-            Description("As the Bachlor \033[1;92m" + state.Bachelor.Name + "\033[0m leaves for the day, the contestants assemble at Boston City Hall to reflect on their first interactions and begin to plan their strategies. A large screen displays the order of events for the season:\n\t1. Cape Cod\n\t2. New England Aquarium\n\t3. The Berkshires\n\t4. Martha's Vineyard\n\nAfter a few minutes, however, the screen updates to show something different..."),
-    ),
-	)
+func (s *Season) RunFirstImpression() error {
+	state := s.State
+	SortByRelationship(state)
 
-	form.Run()
+	err := s.show(Event{
+		Clear: true,
+		Title: "0. First Impressions",
+		Text:  "As the {Bachelor} " + highlightBachelor(state.Bachelor.Name) + " leaves for the day, the contestants assemble at Boston City Hall to reflect on their first interactions and begin to plan their strategies. A large screen displays the order of events for the season:\n\t1. Cape Cod\n\t2. New England Aquarium\n\t3. The Berkshires\n\t4. Hometowns\n\t5. Martha's Vineyard\n\nAfter a few minutes, however, the screen updates to show something different...",
+	})
+	if err != nil {
+		return err
+	}
 
 
 
-	var rankings string
+	rose := s.awardRose(RoseFirstImpression, state.Contestants[0].Name)
+	var standings []Standing
 	var playerPosition int
-	var pos string
 	for i, c := range state.Contestants {
-		pos = strconv.Itoa(i+1)
+		st := Standing{Rank: i + 1, Name: c.Name, IsPlayer: c.IsPlayer, Rose: state.RoseOf(c.Name)}
 		if i == 0 {
-			rankings += "🌹 "
+			st.Status = StandingRose
 		}
 		if c.IsPlayer {
-			rankings += pos + ". \033[1;36m" + c.Name + "\033[0m \n"
 			playerPosition = i
-			} else {
-			rankings += pos + ". \033[95m" + c.Name + "\033[0m \n"
 		}
+		standings = append(standings, st)
 	}
 	var response string
 	if playerPosition < 10 {
-		response = "You're already in the Top 10, \033[1;36m" + state.PlayerCharacter.Name + "\033[0m, and that's before he's really even got to know your incredible personality! You've got a great chance at this." 
+		response = "You're already in the Top 10, " + highlightPlayer(state.PlayerCharacter.Name) + ", and that's before {he} {has} really even gotten to know your incredible personality! You've got a great chance at this." 
 	} else if playerPosition < 20 {
 		response = "Maybe you didn't stand out as much as you'd hoped, but at least you're not in the Bottom 5. You'll have a few chances to shine at Cape Cod."
 	} else {
-		response = "Uh oh, \033[1;36m" + state.PlayerCharacter.Name + "\033[0m, you're already in the Bottom 5. You'll have to work some miracles at Cape Cod to have a chance of staying on the show."
-	}
-	form = huh.NewForm(
-    huh.NewGroup(
-        huh.NewNote().
-            Title("0. First Impressions").
-            Description("LEADERBOARD:\n" + rankings + "\n" + response + "\n\nRegardless, you head to bed for the night and prepare for the big day tomorrow."),
-    ),
-	)
-
-	form.Run()
+		response = "Uh oh, " + highlightPlayer(state.PlayerCharacter.Name) + ", you're already in the Bottom 5. You'll have to work some miracles at Cape Cod to have a chance of staying on the show."
+	}
+	return s.show(Event{
+		Title:     "0. First Impressions",
+		Standings: standings,
+		Text:      rose + "\n\n" + response + "\n\nRegardless, you head to bed for the night and prepare for the big day tomorrow.",
+	})
 }
 
 // 25 - Cape Cod
-func RunSession1(state *GameState) {
-	ClearScreen()
-	var opt string
-	form := huh.NewForm(
-    huh.NewGroup(
-        huh.NewNote().
-            Title("1. Cape Cod").
-            Description("Ah, to be back at the Cape. The May weather is perfect, just cool enough that you can enjoy the heat of the sun on your skin, and the ocean is a sparkling crystalline blue. As you arrive, you see \033[1;92m" + state.Bachelor.Name + "\033[0m waiting on the upper balcony of the beautiful beachside home where you all will be spending the day. As the contestants get settled for the day, everyone separates to participate in different activities."),
-
-				huh.NewSelect[string]().
-						Title("What will you spend the day doing?").
-						Options(
-							huh.NewOption("Hike in the hills nearby", "hike"),
-							huh.NewOption("Play beach volleyball with the other contestants", "volleyball"),
-							huh.NewOption("Relax on the beach", "relax"),
-							).
-						Value(&opt),
-    ),
+func (s *Season) RunSession1() error {
+	if err := s.recap(); err != nil {
+		return err
+	}
+	state := s.State
+	opt, err := s.choose(Prompt{
+		Clear: true,
+		Title: "1. Cape Cod",
+		Text:  "Ah, to be back at the Cape. The May weather is perfect, just cool enough that you can enjoy the heat of the sun on your skin, and the ocean is a sparkling crystalline blue. As you arrive, you see "+highlightBachelor(state.Bachelor.Name)+" waiting on the upper balcony of the beautiful beachside home where you all will be spending the day. As the contestants get settled for the day, everyone separates to participate in different activities.",
+	}, "What will you spend the day doing?",
+		Option{"Hike in the hills nearby", "hike"},
+		Option{"Play beach volleyball with the other contestants", "volleyball"},
+		Option{"Relax on the beach", "relax"},
 	)
+	if err != nil {
+		return err
+	}
 
-	form.Run()
-	ghike, gvolley, grelax := AssignToGroups(state)
-	if opt == "hike" {
-		rn := rand.Intn(20) + state.PlayerCharacter.Strength > 10
-		if rn {
-			form = huh.NewForm(
-				huh.NewGroup(
-						huh.NewNote().
-								Title("1. Cape Cod").
-								Description("On your hike, something."),
-		
-				),
-			)
-		
-			form.Run()
+	before := state.snapshot()
+	hike, volleyball, relax := AssignToGroups(s.Rand, state)
+	switch opt {
+	case "hike":
+		s.groupRolls(volleyball, strength, 12)
+		s.groupRolls(relax, attractiveness, 12)
+		err = s.runHike(hike)
+	case "volleyball":
+		s.groupRolls(hike, strength, 12)
+		s.groupRolls(relax, attractiveness, 12)
+		err = s.runVolleyball(volleyball)
+	case "relax":
+		s.groupRolls(hike, strength, 12)
+		s.groupRolls(volleyball, strength, 12)
+		err = s.runRelax(relax)
+	}
+	if err != nil {
+		return err
+	}
+	if opt == "relax" {
+		state.feel(state.PlayerCharacter.Name, 0, 1, -1)
+	} else {
+		state.feel(state.PlayerCharacter.Name, 0, -2, 0)
+	}
+	if rose := s.groupDateRose(before); rose != "" {
+		if err := s.show(Event{Title: capeCodTitle, Text: "As the sun sets, the {Bachelor} gathers everyone on the deck with one rose in hand.\n\n" + rose}); err != nil {
+			return err
 		}
-	} else if opt == "volleyball" {
-
-	} else if opt == "relax" {
-
+	}
+	if err := s.runCocktailParty(capeCodTitle); err != nil {
+		return err
+	}
+	if err := s.runStrategies(capeCodTitle); err != nil {
+		return err
+	}
+	if err := s.runDrama(capeCodTitle); err != nil {
+		return err
+	}
+	if left, err := s.runMoods(capeCodTitle); err != nil || left {
+		return err
 	}
 
-	RunElimination(state, 10, 15, "1. First Rose Ceremony")
+	return s.RunElimination(10, 15, "1. First Rose Ceremony")
 }
 
 
@@ -265,21 +289,99 @@ func RunSession1(state *GameState) {
 
 
 // 15 - Aqaurium
-func RunSession2(state *GameState) {
-	ClearScreen()
+func (s *Season) RunSession2() error {
+	if err := s.recap(); err != nil {
+		return err
+	}
+	if err := s.RunDowntime("Downtime"); err != nil {
+		return err
+	}
+	state := s.State
+	err := s.show(Event{
+		Clear: true,
+		Title: aquariumTitle,
+		Text:  "The house wakes up to the news: this week's dates are at the New England Aquarium, right on Boston Harbor. One contestant will get a date card all to themselves. Everyone else is headed to the group date.",
+	})
+	if err != nil {
+		return err
+	}
 
-	RunElimination(state, 7, 8, "2. Second Rose Ceremony")
+	before := state.snapshot()
+	dated, err := s.runOneOnOne(aquariumTitle)
+	if err != nil {
+		return err
+	}
+	if err := s.runTankDate(dated); err != nil {
+		return err
+	}
+	if rose := s.groupDateRose(before); rose != "" {
+		if err := s.show(Event{Title: aquariumTitle, Text: "Under the blue glow of the Giant Ocean Tank, the {Bachelor} holds up the group date rose.\n\n" + rose}); err != nil {
+			return err
+		}
+	}
+	if err := s.runCocktailParty(aquariumTitle); err != nil {
+		return err
+	}
+	if err := s.runStrategies(aquariumTitle); err != nil {
+		return err
+	}
+	if err := s.runDrama(aquariumTitle); err != nil {
+		return err
+	}
+	if left, err := s.runMoods(aquariumTitle); err != nil || left {
+		return err
+	}
+	return s.RunElimination(7, 8, "2. Second Rose Ceremony")
 }
 
 
 
 
 
-
 // 8 - Berkshires
-func RunSession3(state *GameState) {
-	ClearScreen()
-	RunElimination(state, 5, 3, "3. Third Rose Ceremony")
+func (s *Season) RunSession3() error {
+	if err := s.recap(); err != nil {
+		return err
+	}
+	if err := s.RunDowntime("Downtime"); err != nil {
+		return err
+	}
+	err := s.show(Event{
+		Clear: true,
+		Title: berkshiresTitle,
+		Text:  "The house packs up for a week in a lodge in the Berkshires. The leaves are turning, the nights are cold, and there are fewer of you every morning.",
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.runTwoOnOne(); err != nil || s.Done() {
+		return err
+	}
+	before := s.State.snapshot()
+	if err := s.runWilderness(); err != nil {
+		return err
+	}
+	if rose := s.groupDateRose(before); rose != "" {
+		if err := s.show(Event{Title: berkshiresTitle, Text: "Around the campfire, the {Bachelor} has one rose to give.\n\n" + rose}); err != nil {
+			return err
+		}
+	}
+	if err := s.runConfessional(); err != nil {
+		return err
+	}
+	if err := s.runCocktailParty(berkshiresTitle); err != nil {
+		return err
+	}
+	if err := s.runStrategies(berkshiresTitle); err != nil {
+		return err
+	}
+	if err := s.runDrama(berkshiresTitle); err != nil {
+		return err
+	}
+	if left, err := s.runMoods(berkshiresTitle); err != nil || left {
+		return err
+	}
+	return s.RunElimination(5, 3, "3. Third Rose Ceremony")
 }
 
 
@@ -287,47 +389,35 @@ func RunSession3(state *GameState) {
 
 
 
-// 3 - Martha's Vineyard
-func RunFantasySuites(state *GameState) {
-	ClearScreen()
-	RunElimination(state, 2, 1, "4. Final Rose Ceremony")
-}
-
-
 
 
 
 
 
-// 1
-func RunProposal(state *GameState) {
 
-}
 
 
 
 
-func AssignToGroups(state *GameState) (group1, group2, group3 []Character) {
-	// Seed the random generator (once in your program)
-	rand.Seed(time.Now().UnixNano())
 
+func AssignToGroups(rng *rand.Rand, state *GameState) (group1, group2, group3 []Character) {
 	// Make a copy so you don't shuffle the original order
 	contestants := append([]Character(nil), state.Contestants...)
-	rand.Shuffle(len(contestants), func(i, j int) {
+	rng.Shuffle(len(contestants), func(i, j int) {
 		contestants[i], contestants[j] = contestants[j], contestants[i]
 	})
 
-	// Split into 3 roughly equal groups
-	for i, c := range contestants {
+	// Everyone picks their own activity
+	for _, c := range contestants {
 		if c.IsPlayer {
 			continue
 		}
-		switch i % 3 {
-		case 0:
+		switch chooseActivity(rng, c) {
+		case activityHike:
 			group1 = append(group1, c)
-		case 1:
+		case activityVolleyball:
 			group2 = append(group2, c)
-		case 2:
+		case activityRelax:
 			group3 = append(group3, c)
 		}
 	}
@@ -339,62 +429,72 @@ func AssignToGroups(state *GameState) (group1, group2, group3 []Character) {
 
 
 
-func RunElimination(state *GameState, num int, numIn int, title string) {
-	sort.Slice(state.Contestants, func(i, j int) bool {
-		a := state.Contestants[i]
-		b := state.Contestants[j]
-		return state.Relationship[a.Name] > state.Relationship[b.Name]
-	})
-	top := state.Contestants[:len(state.Contestants)-num]
-	bottom := state.Contestants[len(state.Contestants)-num:]
+func (s *Season) RunElimination(num int, numIn int, title string) error {
+	state := s.State
+	if left, err := s.beforeCeremony(title); err != nil || left {
+		return err
+	}
+	num = max(num-s.walkouts, 0)
+	s.walkouts = 0
+	defense := s.defendBubble(num)
+	SortByRelationship(state)
+	state.recordScores()
+	for _, c := range state.Contestants {
+		state.RelationshipHistory[c.Name] = append(state.RelationshipHistory[c.Name], state.Relationship[c.Name])
+	}
+	top, bottom := state.splitCeremony(num)
+	if s.pleaded {
+		s.pleaded = false
+		if plea := s.pleadSave(top, bottom); plea != "" {
+			if defense != "" {
+				defense += "\n\n"
+			}
+			defense += plea
+		}
+	}
 	for _, c := range bottom {
 		state.Eliminated = append(state.Eliminated, c.Name)
+		s.record(MomentCut, c.Name, "", capitalize(s.nameOf(c.Name))+" went home without a rose at "+state.Phase.Title()+".")
 	}
 
 	state.Contestants = top
-	var rankings string
-	var pos string
-	eliminated := false
+	if len(top) == 1 && len(bottom) > 0 {
+		state.RunnerUp = bottom[0].Name
+	}
+	s.pendingSave = true
+	var standings []Standing
 	for i, c := range top {
-		pos = strconv.Itoa(i+1)
-		if c.IsPlayer {
-			rankings += "🌹 " + pos + ". \033[1;36m" + c.Name + "\033[0m \n"
-			} else {
-			rankings += "🌹 " + pos + ". \033[95m" + c.Name + "\033[0m \n"
-		}
+		standings = append(standings, Standing{Rank: i + 1, Name: c.Name, IsPlayer: c.IsPlayer, Status: StandingRose, Rose: state.RoseOf(c.Name)})
 	}
 	for i, c := range bottom {
-		pos = strconv.Itoa(i+len(top)+1)
-		if c.IsPlayer {
-			rankings += "❌ " + pos + ". \033[1;36m" + c.Name + "\033[0m \n"
-			eliminated = true
-			} else {
-			rankings += "❌ " + pos + ". \033[91m" + c.Name + "\033[0m \n"
-		}
+		standings = append(standings, Standing{Rank: i + len(top) + 1, Name: c.Name, IsPlayer: c.IsPlayer, Status: StandingEliminated})
+	}
+	ceremony := s.buildCeremony(top, bottom, defense)
+	state.afterCeremony(top, bottom, ceremony.FinalRose.Name)
+	// roses only protect for one ceremony
+	state.Roses = nil
+
+	err := s.show(Event{
+		Clear:     true,
+		Title:     title,
+		Text:      ceremony.Summary(),
+		Standings: standings,
+		Ceremony:  ceremony,
+	})
+	if err != nil {
+		return err
 	}
 
-	form := huh.NewForm(
-    huh.NewGroup(
-        huh.NewNote().
-            Title(title).
-            Description("LEADERBOARD:\n" + rankings + "\n"),
-    ),
-	)
-
-	form.Run()
-
-	if eliminated {
-		form := huh.NewForm(
-			huh.NewGroup(
-					huh.NewNote().
-							Title("The End").
-							Description("Unfortunately, you've been eliminated. Maybe it was the outfit you wore, the comment you made, or just something fundamental about you as a person. Better luck next time!"),
-			),
-		)
-	
-		form.Run()
-		os.Exit(0)
+	if state.PlayerEliminated() {
+		err = s.show(Event{
+			Title: "The End",
+			Text:  "Unfortunately, you've been eliminated. Maybe it was the outfit you wore, the comment you made, or just something fundamental about you as a person. Better luck next time!",
+		})
+		state.Ending = EndingEliminated
+		s.end()
+		return err
 	}
+	return nil
 }
 
 // func RunEpisode1(state *GameState) {
@@ -489,7 +589,7 @@ func isEliminated(state *GameState, name string) bool {
     return false
 }
 
-func EliminateWeighted(state *GameState, count int) {
+func EliminateWeighted(rng *rand.Rand, state *GameState, count int) {
 	// Build a list of weighted entries
 	type weightedEntry struct {
 		Index int
@@ -511,7 +611,7 @@ func EliminateWeighted(state *GameState, count int) {
 	// Sample without replacement
 	eliminatedIndices := map[int]bool{}
 	for len(eliminatedIndices) < count && len(eliminatedIndices) < len(entries) {
-		r := rand.Intn(totalWeight)
+		r := rng.IntN(totalWeight)
 		acc := 0
 		for _, e := range entries {
 			if eliminatedIndices[e.Index] {
@@ -538,12 +638,3 @@ func EliminateWeighted(state *GameState, count int) {
 	state.Contestants = remaining
 }
 
-// Helper to convert names to huh.Option
-func buildOptions(names []string) []huh.Option[string] {
-    opts := []huh.Option[string]{}
-    for _, name := range names {
-        opts = append(opts, huh.NewOption(name, name))
-    }
-    return opts
-}
-
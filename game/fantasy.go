@@ -0,0 +1,221 @@
+package game
+
+import (
+	"math/rand/v2"
+	"strings"
+)
+
+const fantasyTitle = "5. Martha's Vineyard"
+
+// Dealbreaker is something the lead can't get past. The lead has a couple of
+// them from the start of the season, and nobody knows what they are until
+// someone trips one or the player asks the right question.
+type Dealbreaker struct {
+	Trait    string `json:"trait"`
+	Revealed bool   `json:"revealed"`
+}
+
+// dealbreakerTraits are the dealbreakers a lead can have, in the order they
+// are rolled.
+var dealbreakerTraits = []string{"secrets", "pace", "moving", "kids", "family"}
+
+// dealbreakerReveals are what the lead says when a dealbreaker is tripped.
+var dealbreakerReveals = map[string]string{
+	"secrets": "I can forgive a lot, but I can't be with someone who lies to me.",
+	"pace":    "I wanted to take this part slow. I thought you'd understand that.",
+	"moving":  "My whole life is back home. I can't start over somewhere else.",
+	"kids":    "I've wanted a family for as long as I can remember.",
+	"family":  "I can't marry into a family that doesn't want me there.",
+}
+
+// dealbreakerHints are how the player remembers a revealed dealbreaker,
+// following {he}.
+var dealbreakerHints = map[string]string{
+	"secrets": "can't stand being lied to",
+	"pace":    "want{s} to take the overnight slow",
+	"moving":  "won't leave home",
+	"kids":    "want{s} kids",
+	"family":  "need{s} the family's blessing",
+}
+
+// rollDealbreakers picks the lead's hidden dealbreakers.
+func rollDealbreakers(rng *rand.Rand) []Dealbreaker {
+	var out []Dealbreaker
+	for _, i := range rng.Perm(len(dealbreakerTraits))[:2] {
+		out = append(out, Dealbreaker{Trait: dealbreakerTraits[i]})
+	}
+	return out
+}
+
+// tripDealbreaker costs a contestant dearly if the lead has trait as a
+// dealbreaker, and reveals it. It returns what the lead says, or "" if the
+// lead doesn't mind.
+func (s *Season) tripDealbreaker(name, trait string) string {
+	for i, d := range s.State.Bachelor.Profile.Dealbreakers {
+		if d.Trait != trait {
+			continue
+		}
+		s.State.Bachelor.Profile.Dealbreakers[i].Revealed = true
+		s.adjust(name, -4)
+		s.record(MomentDealbreaker, name, "", capitalize(s.nameOf(name))+" ran into one of the {Bachelor}'s dealbreakers: {he} "+dealbreakerHints[trait]+".")
+		return "\"" + dealbreakerReveals[trait] + "\""
+	}
+	return ""
+}
+
+// knownDealbreakers describes the lead's revealed dealbreakers.
+func (state *GameState) knownDealbreakers() []string {
+	var out []string
+	for _, d := range state.Bachelor.Profile.Dealbreakers {
+		if d.Revealed {
+			out = append(out, dealbreakerHints[d.Trait])
+		}
+	}
+	return out
+}
+
+// RunFantasySuites offers each of the final contestants a night off camera
+// with the lead, then holds the final rose ceremony. The player's night is
+// played out; everyone else's is a montage.
+func (s *Season) RunFantasySuites() error {
+	if err := s.recap(); err != nil {
+		return err
+	}
+	state := s.State
+	err := s.show(Event{
+		Clear: true,
+		Title: fantasyTitle,
+		Text:  "It's fantasy suite week on Martha's Vineyard. The final contestants arrive at separate suites overlooking the ocean. Each one will get a date card inviting them to forgo their individual rooms and spend the night with " + highlightBachelor(state.Bachelor.Name) + ", away from the cameras.",
+	})
+	if err != nil {
+		return err
+	}
+
+	var montage []string
+	for _, c := range state.Contestants {
+		if c.IsPlayer {
+			continue
+		}
+		montage = append(montage, s.npcSuite(c))
+	}
+	if len(montage) > 0 {
+		if err := s.show(Event{Title: fantasyTitle, Text: strings.Join(montage, "\n\n")}); err != nil {
+			return err
+		}
+	}
+
+	if !state.PlayerEliminated() {
+		if err := s.playerSuite(); err != nil {
+			return err
+		}
+	}
+	return s.RunElimination(2, 1, "5. Final Rose Ceremony")
+}
+
+// npcSuite rolls a contestant's night and returns its montage line.
+func (s *Season) npcSuite(c Character) string {
+	who := highlightContestant(c.Name)
+	var text []string
+	if s.Rand.IntN(4) > 0 {
+		s.adjust(c.Name, 1)
+		text = append(text, who+" accepts the overnight card.")
+		if reveal := s.tripDealbreaker(c.Name, "pace"); reveal != "" {
+			text = append(text, "By morning, the {Bachelor} is distant. "+reveal)
+		}
+	} else {
+		text = append(text, who+" declines the overnight card and spends the night talking on the balcony instead.")
+	}
+
+	if s.State.HometownApproval[c.Name] < 0 {
+		if reveal := s.tripDealbreaker(c.Name, "family"); reveal != "" {
+			text = append(text, "Hometowns come up, and the {Bachelor} can't let it go. "+reveal)
+		}
+	}
+	switch {
+	case s.Rand.IntN(3) == 0 && !s.statCheck(c.Charisma, 13):
+		s.adjust(c.Name, -2)
+		text = append(text, "The {Bachelor} catches "+who+" in a lie.")
+		if reveal := s.tripDealbreaker(c.Name, "secrets"); reveal != "" {
+			text = append(text, reveal)
+		}
+	case s.Rand.IntN(3) == 0:
+		trait := "moving"
+		if s.Rand.IntN(2) == 0 {
+			trait = "kids"
+		}
+		if reveal := s.tripDealbreaker(c.Name, trait); reveal != "" {
+			text = append(text, "The talk turns to the future, and "+who+" says the wrong thing. "+reveal)
+		}
+	case s.statCheck(c.Charisma+c.Attractiveness, 12):
+		s.adjust(c.Name, 2)
+		text = append(text, "They talk until sunrise.")
+	}
+	return strings.Join(text, " ")
+}
+
+// playerSuite plays out the player's night: the overnight card, then a
+// conversation that tests how honest the player is and how well they fit
+// with the lead.
+func (s *Season) playerSuite() error {
+	state := s.State
+	p := state.PlayerCharacter
+	b := highlightBachelor(state.Bachelor.Name)
+
+	text := "Your date card reads: \"Forgo your individual room and spend the night with me.\""
+	if known := state.knownDealbreakers(); len(known) > 0 {
+		text += "\n\nAfter watching the others, you know this much about " + b + ": {he} " + joinList(known) + "."
+	}
+	card, err := s.choose(Prompt{Clear: true, Title: fantasyTitle, Text: text},
+		"Do you accept?",
+		Option{"Accept the card", "accept"},
+		Option{"Decline and ask to just talk", "decline"},
+	)
+	if err != nil {
+		return err
+	}
+	if card == "accept" {
+		s.adjust(p.Name, 2)
+		text = "You accept, and " + b + " lets out a breath {he} didn't know {he} {was} holding."
+		if reveal := s.tripDealbreaker(p.Name, "pace"); reveal != "" {
+			text = "You accept, but " + b + " hesitates. " + reveal
+		}
+	} else {
+		text = "You tell " + b + " you'd rather just talk tonight. {He} look{s} surprised, then relieved."
+		if s.hasDealbreaker("pace") {
+			s.adjust(p.Name, 2)
+			text += " \"Thank you,\" {he} say{s} quietly. \"I needed that.\""
+		}
+	}
+	if err := s.show(Event{Title: fantasyTitle, Text: text}); err != nil {
+		return err
+	}
+
+	if state.HometownApproval[p.Name] < 0 {
+		if reveal := s.tripDealbreaker(p.Name, "family"); reveal != "" {
+			err := s.show(Event{Title: fantasyTitle, Text: b + " can't stop thinking about your hometown. " + reveal})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if _, err := s.RunDialogue(fantasyTitle, "fantasy-suite"); err != nil {
+		return err
+	}
+
+	text = "The sun comes up over the water. Whatever happens at the final rose ceremony, tonight was real."
+	if known := state.knownDealbreakers(); len(known) > 0 {
+		text += "\n\nWhat you've learned about " + b + ": {he} " + joinList(known) + "."
+	}
+	return s.show(Event{Title: fantasyTitle, Text: text})
+}
+
+// hasDealbreaker reports whether the lead has trait as a dealbreaker.
+func (s *Season) hasDealbreaker(trait string) bool {
+	for _, d := range s.State.Bachelor.Profile.Dealbreakers {
+		if d.Trait == trait {
+			return true
+		}
+	}
+	return false
+}
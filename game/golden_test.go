@@ -0,0 +1,98 @@
+package game
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+// goldenSeasons are full seasons played from a fixed seed. Each plays its
+// answers and then presses Enter through the rest of the season.
+var goldenSeasons = []struct {
+	name    string
+	seed    uint64
+	answers []string
+}{
+	{"bachelor", 1, []string{"Ellory", "they", "Bachelor", "he", "3", "3", "3"}},
+	{"bachelorette-they", 8, []string{"Sam", "he", "Bachelorette", "they", "5", "2", "2"}},
+	{"eliminated", 7, []string{"Jordan", "she", "Bachelor", "she", "4", "4", "1"}},
+}
+
+func TestGoldenSeasons(t *testing.T) {
+	for _, tc := range goldenSeasons {
+		t.Run(tc.name, func(t *testing.T) {
+			script := NewScript(append(slices.Clone(tc.answers), make([]string, 500)...)...)
+			state := NewGameState()
+			if err := NewSeason(&state, script, tc.seed).Run(); err != nil {
+				t.Fatal(err)
+			}
+			data, err := json.MarshalIndent(state, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := "== transcript ==\n" + script.Transcript() +
+				"\n== leaderboard ==\n" + StripColors(FormatLeaderboard(finalStandings(&state))) +
+				"\n== state ==\n" + string(data) + "\n"
+
+			path := filepath.Join("testdata", tc.name+".golden")
+			if *update {
+				if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("%v (run go test -update to create it)", err)
+			}
+			if got != string(want) {
+				t.Errorf("season does not match %s (run go test -update if the change is intended)\n%s", path, firstDiff(string(want), got))
+			}
+		})
+	}
+}
+
+// finalStandings is the leaderboard at the end of the season: whoever is
+// left, then everyone who went home, the latest to go first. How many
+// ceremonies someone made it to says when they went; those who went
+// together keep the order they went in.
+func finalStandings(state *GameState) []Standing {
+	SortByRelationship(state)
+	var standings []Standing
+	for _, c := range state.Contestants {
+		standings = append(standings, Standing{Rank: len(standings) + 1, Name: c.Name, IsPlayer: c.IsPlayer, Rose: state.RoseOf(c.Name)})
+	}
+	gone := slices.Clone(state.Eliminated)
+	slices.SortStableFunc(gone, func(a, b string) int {
+		return len(state.RelationshipHistory[b]) - len(state.RelationshipHistory[a])
+	})
+	for _, name := range gone {
+		standings = append(standings, Standing{Rank: len(standings) + 1, Name: name, IsPlayer: name == state.PlayerCharacter.Name, Status: StandingEliminated})
+	}
+	return standings
+}
+
+// firstDiff points at the first line where two goldens part ways.
+func firstDiff(want, got string) string {
+	w, g := strings.Split(want, "\n"), strings.Split(got, "\n")
+	for i := range max(len(w), len(g)) {
+		var wl, gl string
+		if i < len(w) {
+			wl = w[i]
+		}
+		if i < len(g) {
+			gl = g[i]
+		}
+		if wl != gl {
+			return "line " + strconv.Itoa(i+1) + ":\n  want: " + wl + "\n  got:  " + gl
+		}
+	}
+	return ""
+}
@@ -0,0 +1,197 @@
+package game
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+const hometownsTitle = "4. Hometowns"
+
+// FamilyMember is someone the lead meets on a hometown date.
+type FamilyMember struct {
+	Relation    string `json:"relation"`
+	Name        string `json:"name"`
+	Temperament string `json:"temperament"`
+}
+
+// Temperaments are the family temperaments a player can pick from. How each
+// one takes to the lead is written in the hometown dialogue, which has a node
+// for every temperament.
+var Temperaments = []Option{
+	{"Warm", "warm"},
+	{"Protective", "protective"},
+	{"Skeptical", "skeptical"},
+	{"Competitive", "competitive"},
+}
+
+// FamilySizes are the families a player can bring the lead home to.
+var FamilySizes = []Option{
+	{"Mom and dad", "parents"},
+	{"Mom, dad and a sibling", "siblings"},
+	{"Just mom", "mom"},
+	{"Surprise me", ""},
+}
+
+// GenerateFamily builds a family. An empty size or temperament is rolled.
+func GenerateFamily(rng *rand.Rand, size, temperament string) []FamilyMember {
+	if size == "" {
+		size = FamilySizes[rng.IntN(len(FamilySizes)-1)].Value
+	}
+	roll := func() string {
+		if temperament != "" {
+			return temperament
+		}
+		return Temperaments[rng.IntN(len(Temperaments))].Value
+	}
+	family := []FamilyMember{{Relation: "mom", Temperament: roll()}}
+	if size != "mom" {
+		family = append(family, FamilyMember{Relation: "dad", Temperament: roll()})
+	}
+	if size == "siblings" {
+		name := content.ContestantNames[rng.IntN(len(content.ContestantNames))]
+		if rng.IntN(2) == 0 {
+			name = content.BachelorNames[rng.IntN(len(content.BachelorNames))]
+		}
+		family = append(family, FamilyMember{Relation: "sibling", Name: name, Temperament: roll()})
+	}
+	return family
+}
+
+// who is how the narrative refers to a family member.
+func (m FamilyMember) who() string {
+	if m.Name != "" {
+		return "your " + m.Relation + " " + m.Name
+	}
+	return "your " + m.Relation
+}
+
+// RunHometowns sends the lead home with each of the final contestants. The
+// player's visit is played out; everyone else's is a montage. Every visit
+// moves the contestant's standing going into the final rose ceremony.
+func (s *Season) RunHometowns() error {
+	if err := s.recap(); err != nil {
+		return err
+	}
+	state := s.State
+	b := highlightBachelor(state.Bachelor.Name)
+
+	if err := s.RunDowntime("Downtime"); err != nil {
+		return err
+	}
+	err := s.show(Event{
+		Clear: true,
+		Title: hometownsTitle,
+		Text:  "Only " + strconv.Itoa(len(state.Contestants)) + " contestants are left, and it's time for " + b + " to meet the people who know them best. One by one, {he} visit{s} each of their hometowns.",
+	})
+	if err != nil {
+		return err
+	}
+
+	var montage []string
+	for _, c := range state.Contestants {
+		if c.IsPlayer {
+			continue
+		}
+		montage = append(montage, s.npcHometown(c))
+	}
+	if len(montage) > 0 {
+		err = s.show(Event{Title: hometownsTitle, Text: strings.Join(montage, "\n\n")})
+		if err != nil {
+			return err
+		}
+	}
+
+	if state.PlayerEliminated() {
+		return nil
+	}
+	return s.playerHometown()
+}
+
+// npcHometown rolls a contestant's visit home and returns its montage line.
+func (s *Season) npcHometown(c Character) string {
+	who := highlightContestant(c.Name)
+	where := c.Hometown
+	if where == "" {
+		where = "home"
+	}
+	switch roll := s.Rand.IntN(20) + c.Charisma; {
+	case roll > 16:
+		s.adjust(c.Name, 3)
+		s.State.HometownApproval[c.Name] = 3
+		return "In " + where + ", " + who + "'s family welcomes the {Bachelor} like one of their own. There are happy tears at the door."
+	case roll > 8:
+		s.adjust(c.Name, 1)
+		s.State.HometownApproval[c.Name] = 1
+		return "In " + where + ", " + who + "'s family is polite but careful. Dinner goes fine, and nobody cries."
+	default:
+		s.adjust(c.Name, -2)
+		s.State.HometownApproval[c.Name] = -2
+		return "In " + where + ", " + who + "'s older brother pulls the {Bachelor} aside and asks what {his} intentions are. It does not go well."
+	}
+}
+
+func (s *Season) playerHometown() error {
+	state := s.State
+	p := state.PlayerCharacter
+	b := highlightBachelor(state.Bachelor.Name)
+	family := p.Family
+	if len(family) == 0 {
+		family = GenerateFamily(s.Rand, "", "")
+	}
+	where := p.Hometown
+	if where == "" {
+		where = "your hometown"
+	}
+
+	var names []string
+	for _, m := range family {
+		names = append(names, m.who())
+	}
+	err := s.show(Event{
+		Title: hometownsTitle,
+		Text:  "Last stop: " + where + ". You hold " + b + "'s hand on the front step while " + joinList(names) + " " + plural(names, "waits", "wait") + " inside.",
+	})
+	if err != nil {
+		return err
+	}
+
+	approval := 0
+	for _, m := range family {
+		c, err := s.conversation("hometown", m.Temperament)
+		if err != nil {
+			return err
+		}
+		c.Vars["family"] = m.who()
+		if err := s.talk(Prompt{Title: hometownsTitle}, c); err != nil {
+			return err
+		}
+		approval += c.Score
+	}
+
+	state.HometownApproval[p.Name] = approval
+	s.adjust(p.Name, approval)
+	var verdict string
+	switch {
+	case approval >= 2*len(family):
+		verdict = "On the porch, " + b + " tells you {he} could see {himself} at every one of these family dinners. Your family is all in."
+	case approval > 0:
+		verdict = "Your family gives you a cautious thumbs up as the car pulls away. It's a start."
+	default:
+		verdict = "The car ride back is quiet. Your family had some concerns, and " + b + " heard every one of them."
+	}
+	return s.show(Event{Title: hometownsTitle, Text: verdict})
+}
+
+// joinList joins plain words into a readable list.
+func joinList(items []string) string {
+	switch len(items) {
+	case 0:
+		return "nobody"
+	case 1:
+		return items[0]
+	case 2:
+		return items[0] + " and " + items[1]
+	}
+	return strings.Join(items[:len(items)-1], ", ") + ", and " + items[len(items)-1]
+}
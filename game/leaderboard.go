@@ -0,0 +1,65 @@
+package game
+
+import (
+	"sort"
+	"strconv"
+)
+
+type StandingStatus int
+
+const (
+	StandingNone StandingStatus = iota
+	StandingRose
+	StandingEliminated
+)
+
+// Standing is one row of a leaderboard.
+type Standing struct {
+	Rank     int
+	Name     string
+	IsPlayer bool
+	Status   StandingStatus
+	// Rose is the rose the contestant held going into the ceremony.
+	Rose RoseKind
+}
+
+// SortByRelationship orders the remaining contestants from the Bachelor's
+// favourite to his least favourite.
+func SortByRelationship(state *GameState) {
+	sort.Slice(state.Contestants, func(i, j int) bool {
+		a := state.Contestants[i]
+		b := state.Contestants[j]
+		return state.Relationship[a.Name] > state.Relationship[b.Name]
+	})
+}
+
+// FormatLeaderboard renders standings the way every front-end shows them.
+func FormatLeaderboard(standings []Standing) string {
+	var rankings string
+	for _, st := range standings {
+		pos := strconv.Itoa(st.Rank)
+		held := ""
+		if st.Rose != RoseNone {
+			held = "(" + st.Rose.String() + ") "
+		}
+		switch st.Status {
+		case StandingRose:
+			rankings += "🌹 "
+		case StandingEliminated:
+			rankings += "❌ "
+		}
+		rankings += pos + ". " + HighlightStanding(st) + " " + held + "\n"
+	}
+	return rankings
+}
+
+// HighlightStanding colors a contestant's name the way the leaderboard does.
+func HighlightStanding(st Standing) string {
+	switch {
+	case st.IsPlayer:
+		return highlightPlayer(st.Name)
+	case st.Status == StandingEliminated:
+		return highlightEliminated(st.Name)
+	}
+	return highlightContestant(st.Name)
+}
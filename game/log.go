@@ -0,0 +1,212 @@
+package game
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Kinds of Moment, from the ones a recap can least afford to leave out.
+const (
+	// MomentCut is going home at a rose ceremony.
+	MomentCut         = "cut"
+	MomentEliminated  = "eliminated"
+	MomentWalkout     = "walkout"
+	MomentProposal    = "proposal"
+	MomentRose        = "rose"
+	MomentDealbreaker = "dealbreaker"
+	MomentDrama       = "drama"
+	MomentBreakdown   = "breakdown"
+	MomentDate        = "date"
+	MomentSteal       = "steal"
+	MomentStreak      = "streak"
+	MomentMove        = "move"
+	// MomentScore is how far a contestant's score moved between ceremonies.
+	MomentScore = "score"
+)
+
+var momentOrder = []string{
+	MomentCut, MomentEliminated, MomentWalkout, MomentProposal, MomentRose, MomentDealbreaker, MomentDrama,
+	MomentBreakdown, MomentDate, MomentSteal, MomentStreak, MomentMove, MomentScore,
+}
+
+// maxRecapLines is how many moments a "Previously on..." recap shows, and
+// maxReelLines how many each contestant gets in the highlight reel.
+const (
+	maxRecapLines = 6
+	maxReelLines  = 3
+	// maxReelContestants is how many contestants besides the player make
+	// the highlight reel, counting back from whoever lasted longest.
+	maxReelContestants = 8
+)
+
+// Moment is something that happened during the season, kept for the recaps.
+type Moment struct {
+	Phase Phase  `json:"phase"`
+	Kind  string `json:"kind"`
+	Name  string `json:"name"`
+	Other string `json:"other,omitempty"`
+	Delta int    `json:"delta,omitempty"`
+	// Text is how the moment reads in a recap.
+	Text string `json:"text,omitempty"`
+}
+
+// record adds a moment to the season's log.
+func (s *Season) record(kind, name, other, text string) {
+	s.State.Log = append(s.State.Log, Moment{Phase: s.State.Phase, Kind: kind, Name: name, Other: other, Text: text})
+}
+
+// recordScores logs how far everyone's score moved since the last
+// ceremony. It has to run before the ceremony adds to RelationshipHistory.
+func (state *GameState) recordScores() {
+	for _, c := range state.Contestants {
+		before := 0
+		if h := state.RelationshipHistory[c.Name]; len(h) > 0 {
+			before = h[len(h)-1]
+		}
+		state.Log = append(state.Log, Moment{Phase: state.Phase, Kind: MomentScore, Name: c.Name, Delta: state.Relationship[c.Name] - before})
+	}
+}
+
+// keyMoments picks the n moments a recap can least afford to leave out,
+// and plays them back in the order they happened.
+func keyMoments(moments []Moment, n int) []Moment {
+	order := make([]int, len(moments))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return slices.Index(momentOrder, moments[a].Kind) - slices.Index(momentOrder, moments[b].Kind)
+	})
+	order = order[:min(n, len(order))]
+	slices.Sort(order)
+	picked := make([]Moment, len(order))
+	for i, j := range order {
+		picked[i] = moments[j]
+	}
+	return picked
+}
+
+// recap opens a session with what happened in the last one.
+func (s *Season) recap() error {
+	state := s.State
+	var moments, scores []Moment
+	var cut []string
+	for _, m := range state.Log {
+		if m.Phase != state.Phase-1 {
+			continue
+		}
+		switch {
+		case m.Kind == MomentScore:
+			scores = append(scores, m)
+		case m.Kind == MomentCut:
+			cut = append(cut, m.Name)
+		case m.Text != "":
+			moments = append(moments, m)
+		}
+	}
+	if len(moments)+len(scores)+len(cut) == 0 {
+		return nil
+	}
+	var lines []string
+	if len(cut) > 0 {
+		// everyone cut at the ceremony shares a line
+		lines = append(lines, "• "+nameList(cut)+" went home without a rose.")
+	}
+	for _, m := range keyMoments(moments, maxRecapLines) {
+		lines = append(lines, "• "+m.Text)
+	}
+
+	var best Moment
+	for _, m := range scores {
+		switch {
+		case m.Name == state.PlayerCharacter.Name && m.Delta == 0:
+			lines = append(lines, "• Your score didn't move.")
+		case m.Name == state.PlayerCharacter.Name:
+			lines = append(lines, "• Your score moved "+signed(m.Delta)+".")
+		case m.Delta > best.Delta:
+			best = m
+		}
+	}
+	if best.Name != "" {
+		lines = append(lines, "• "+highlightContestant(best.Name)+" climbed the most, "+signed(best.Delta)+".")
+	}
+	return s.show(Event{
+		Clear: true,
+		Title: "Previously on The {Bachelor}...",
+		Text:  "Last time, at " + (state.Phase - 1).Title() + ":\n\n" + strings.Join(lines, "\n"),
+	})
+}
+
+// signed is a score change with its sign.
+func signed(n int) string {
+	if n > 0 {
+		return "+" + strconv.Itoa(n)
+	}
+	return strconv.Itoa(n)
+}
+
+// showHighlights is the end-of-season reel: the player's key moments and
+// those of the contestants who lasted longest, with how their scores went.
+func (s *Season) showHighlights() error {
+	state := s.State
+	if len(state.Log) == 0 {
+		return nil
+	}
+	// whoever is still in the house lasted longest, then the last to go
+	var names []string
+	for _, c := range state.Contestants {
+		names = append(names, c.Name)
+	}
+	for i := len(state.Eliminated) - 1; i >= 0; i-- {
+		names = append(names, state.Eliminated[i])
+	}
+	p := state.PlayerCharacter.Name
+	reel := []string{p}
+	for _, n := range names {
+		if len(reel) > maxReelContestants {
+			break
+		}
+		if n != p {
+			reel = append(reel, n)
+		}
+	}
+
+	var sections []string
+	for _, name := range reel {
+		var moments []Moment
+		for _, m := range state.Log {
+			if (m.Name == name || m.Other == name) && m.Kind != MomentScore && m.Text != "" {
+				moments = append(moments, m)
+			}
+		}
+		if len(moments) == 0 {
+			continue
+		}
+		moments = keyMoments(moments, maxReelLines)
+		header := highlightContestant(name)
+		if name == p {
+			header = highlightPlayer(name) + " (you)"
+		}
+		lines := []string{header}
+		for _, m := range moments {
+			lines = append(lines, "• "+m.Text)
+		}
+		if h := state.RelationshipHistory[name]; len(h) > 0 {
+			scores := make([]string, len(h))
+			for i, v := range h {
+				scores[i] = strconv.Itoa(v)
+			}
+			lines = append(lines, "  Score at each ceremony: "+strings.Join(scores, " → "))
+		}
+		sections = append(sections, strings.Join(lines, "\n"))
+	}
+	if len(sections) == 0 {
+		return nil
+	}
+	return s.show(Event{
+		Clear: true,
+		Title: "🎬 Season Highlights 🎬",
+		Text:  strings.Join(sections, "\n\n"),
+	})
+}
@@ -0,0 +1,238 @@
+package game
+
+import "strings"
+
+// Where everyone's meters start, and their limits. Mood runs from -5 to 5;
+// energy and stress run from 0 to 10.
+const (
+	startEnergy = 7
+	startStress = 2
+	moodLimit   = 5
+	meterMax    = 10
+	// streakLength is how many sessions a confident streak lasts.
+	streakLength = 2
+)
+
+// initMeters starts everyone in the house rested and only a little nervous.
+func (state *GameState) initMeters() {
+	for i := range state.Contestants {
+		state.Contestants[i].Energy = startEnergy
+		state.Contestants[i].Stress = startStress
+	}
+	state.PlayerCharacter.Energy = startEnergy
+	state.PlayerCharacter.Stress = startStress
+}
+
+func clamp(v, lo, hi int) int {
+	return min(max(v, lo), hi)
+}
+
+// feel moves a contestant's mood, energy and stress.
+func (state *GameState) feel(name string, mood, energy, stress int) {
+	apply := func(c *Character) {
+		c.Mood = clamp(c.Mood+mood, -moodLimit, moodLimit)
+		c.Energy = clamp(c.Energy+energy, 0, meterMax)
+		c.Stress = clamp(c.Stress+stress, 0, meterMax)
+	}
+	for i := range state.Contestants {
+		if state.Contestants[i].Name == name {
+			apply(&state.Contestants[i])
+		}
+	}
+	if state.PlayerCharacter.Name == name {
+		apply(&state.PlayerCharacter)
+	}
+}
+
+// setStreak starts or winds down a contestant's confident streak.
+func (state *GameState) setStreak(name string, streak int) {
+	for i := range state.Contestants {
+		if state.Contestants[i].Name == name {
+			state.Contestants[i].Streak = streak
+		}
+	}
+	if state.PlayerCharacter.Name == name {
+		state.PlayerCharacter.Streak = streak
+	}
+}
+
+// form is what a contestant's state of mind adds to their rolls. Running on
+// empty or stressed out costs them; a good mood or a confident streak helps.
+func (state *GameState) form(name string) int {
+	c, ok := state.character(name)
+	if !ok {
+		return 0
+	}
+	f := 0
+	switch {
+	case c.Energy <= 2:
+		f -= 2
+	case c.Energy <= 4:
+		f--
+	}
+	switch {
+	case c.Stress >= 8:
+		f -= 2
+	case c.Stress >= 6:
+		f--
+	}
+	switch {
+	case c.Mood >= 2:
+		f++
+	case c.Mood <= -2:
+		f--
+	}
+	if c.Streak > 0 {
+		f += 2
+	}
+	return f
+}
+
+// check is a statCheck for a contestant, in whatever form they're in.
+func (s *Season) check(name string, stat, difficulty int) bool {
+	return s.statCheck(stat+s.State.form(name), difficulty)
+}
+
+// meters describes how the player is holding up.
+func meters(c Character) string {
+	var mood string
+	switch {
+	case c.Mood >= 3:
+		mood = "on top of the world"
+	case c.Mood > 0:
+		mood = "good"
+	case c.Mood == 0:
+		mood = "fine"
+	case c.Mood > -3:
+		mood = "down"
+	default:
+		mood = "miserable"
+	}
+	text := "Mood: " + mood + ". Energy: " + strings.Repeat("▮", c.Energy) + strings.Repeat("▯", meterMax-c.Energy) +
+		". Stress: " + strings.Repeat("▮", c.Stress) + strings.Repeat("▯", meterMax-c.Stress) + "."
+	if c.Streak > 0 {
+		text += " You're on a confident streak."
+	}
+	return text
+}
+
+// runMoods checks in on how everyone is holding up. Stress can boil over
+// into a breakdown, a contestant who is miserable and worn out may go home
+// on their own, and one who is riding high goes on a confident streak. It
+// reports whether the player went home.
+func (s *Season) runMoods(title string) (bool, error) {
+	state := s.State
+	var lines []string
+	// copy the house, since contestants may leave
+	for _, c := range append([]Character(nil), state.Contestants...) {
+		who := highlightContestant(c.Name)
+		switch {
+		case c.Stress >= 9:
+			s.adjust(c.Name, -2)
+			state.feel(c.Name, -2, -2, 5-c.Stress)
+			s.record(MomentBreakdown, c.Name, "", capitalize(s.nameOf(c.Name))+" broke down in the confessional.")
+			if c.IsPlayer {
+				lines = append(lines, "It all catches up with you. You break down in the confessional, and the {Bachelor} finds out before the night is over.")
+			} else {
+				lines = append(lines, who+" breaks down in the confessional. The {Bachelor} finds out before the night is over.")
+			}
+		case c.Mood <= -2 && c.Energy <= 5:
+			if c.IsPlayer {
+				left, err := s.homesick(title)
+				if err != nil || left {
+					return left, err
+				}
+				continue
+			}
+			if len(state.Contestants) <= 4 || s.Rand.IntN(2) == 0 {
+				continue
+			}
+			state.Eliminated = append(state.Eliminated, c.Name)
+			for i, x := range state.Contestants {
+				if x.Name == c.Name {
+					state.Contestants = append(state.Contestants[:i], state.Contestants[i+1:]...)
+					break
+				}
+			}
+			s.walkouts++
+			s.record(MomentWalkout, c.Name, "", who+" packed up and went home, homesick and exhausted.")
+			lines = append(lines, who+" is homesick and exhausted, and packs up to go home before the ceremony.")
+		case c.Mood >= 2 && c.Streak == 0:
+			state.setStreak(c.Name, streakLength)
+			s.record(MomentStreak, c.Name, "", capitalize(s.nameOf(c.Name))+" went on a confident streak.")
+			if c.IsPlayer {
+				lines = append(lines, "You can't miss lately. You're on a confident streak, and everyone can see it.")
+			} else {
+				lines = append(lines, who+" is on a confident streak. Nothing seems to rattle "+state.narrateAbout(c.Name, "{him}")+".")
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return false, nil
+	}
+	return false, s.show(Event{Title: title, Text: strings.Join(lines, "\n\n")})
+}
+
+// homesick asks a worn-out, miserable player whether they want to go home.
+// It reports whether they left.
+func (s *Season) homesick(title string) (bool, error) {
+	state := s.State
+	opt, err := s.choose(Prompt{
+		Title: title,
+		Text:  "You're exhausted, and you haven't had a good day in a while. Lying awake, you miss home more than you thought you would.",
+	}, "What do you do?",
+		Option{"Pull yourself together and stay", "stay"},
+		Option{"Pack your bags and go home", "leave"},
+	)
+	if err != nil {
+		return false, err
+	}
+	if opt != "leave" {
+		state.feel(state.PlayerCharacter.Name, 2, 0, 1)
+		return false, nil
+	}
+	state.Eliminated = append(state.Eliminated, state.PlayerCharacter.Name)
+	for i, c := range state.Contestants {
+		if c.IsPlayer {
+			state.Contestants = append(state.Contestants[:i], state.Contestants[i+1:]...)
+			break
+		}
+	}
+	state.Ending = EndingWalkedAway
+	s.record(MomentWalkout, state.PlayerCharacter.Name, "", "You packed up and went home, homesick and exhausted.")
+	s.pendingSave = true
+	s.end()
+	return true, s.show(Event{
+		Title: "The End",
+		Text:  "You leave a note for the {Bachelor} and slip out before sunrise. Some things matter more than a rose.",
+	})
+}
+
+// afterCeremony is how a ceremony leaves the house: everyone is tired,
+// whoever held a rose is glowing, whoever scraped through on the last rose
+// is rattled, and anyone who lost an ally is sad to see them go.
+func (state *GameState) afterCeremony(top, bottom []Character, lastRose string) {
+	going := map[string]bool{}
+	for _, c := range bottom {
+		going[c.Name] = true
+	}
+	for _, c := range top {
+		mood, stress := 0, -1
+		if state.RoseOf(c.Name) != RoseNone {
+			mood++
+		}
+		if c.Name == lastRose {
+			stress += 3
+		}
+		for _, ally := range state.Allies(c.Name) {
+			if going[ally] {
+				mood--
+				break
+			}
+		}
+		state.feel(c.Name, mood, -1, stress)
+		if c.Streak > 0 {
+			state.setStreak(c.Name, c.Streak-1)
+		}
+	}
+}
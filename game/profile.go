@@ -0,0 +1,271 @@
+package game
+
+import (
+	"math/rand/v2"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Profile is what the lead is really looking for. Nobody sees it, but every
+// change in the lead's feelings goes through it: contestants the lead is
+// drawn to gain a little more and lose a little less, and contestants the
+// lead isn't drawn to have to work harder.
+type Profile struct {
+	// Charisma, Attractiveness and Strength weigh how much the lead cares
+	// about each stat.
+	Charisma       int `json:"charisma"`
+	Attractiveness int `json:"attractiveness"`
+	Strength       int `json:"strength"`
+	// Likes and Dislikes are personalities.
+	Likes    []string `json:"likes"`
+	Dislikes []string `json:"dislikes"`
+	// HairColor and EyeColor are the lead's type, and Taller is whether
+	// the lead likes contestants taller than them.
+	HairColor string `json:"hair_color"`
+	EyeColor  string `json:"eye_color"`
+	Taller    bool   `json:"taller"`
+	// Dealbreakers are tripped at the fantasy suites, or uncovered by asking.
+	Dealbreakers []Dealbreaker `json:"dealbreakers"`
+	// Hints are the topics the player has found out about.
+	Hints []string `json:"hints"`
+}
+
+// GenerateProfile rolls a lead's hidden tastes.
+func GenerateProfile(rng *rand.Rand) Profile {
+	p := Profile{
+		Charisma:       1,
+		Attractiveness: 1,
+		Strength:       1,
+		HairColor:      content.HairColors[rng.IntN(len(content.HairColors))],
+		EyeColor:       content.EyeColors[rng.IntN(len(content.EyeColors))],
+		Taller:         rng.IntN(2) == 0,
+		Dealbreakers:   rollDealbreakers(rng),
+	}
+	for range 3 {
+		switch rng.IntN(3) {
+		case 0:
+			p.Charisma++
+		case 1:
+			p.Attractiveness++
+		case 2:
+			p.Strength++
+		}
+	}
+	picks := rng.Perm(len(content.Personalities))
+	for i, n := range picks {
+		switch {
+		case i < 2:
+			p.Likes = append(p.Likes, content.Personalities[n])
+		case i < 4:
+			p.Dislikes = append(p.Dislikes, content.Personalities[n])
+		}
+	}
+	return p
+}
+
+// chemistry is the lead's first impression of a contestant, on the same
+// 2-8 scale as two stats added together.
+func (p Profile) chemistry(c Character) int {
+	total := p.Charisma + p.Attractiveness + p.Strength
+	if total == 0 {
+		return c.Charisma + c.Attractiveness
+	}
+	return 2 * (p.Charisma*c.Charisma + p.Attractiveness*c.Attractiveness + p.Strength*c.Strength) / total
+}
+
+// favoriteStat is the stat the lead weighs most.
+func (p Profile) favoriteStat() string {
+	switch {
+	case p.Charisma >= p.Attractiveness && p.Charisma >= p.Strength:
+		return "charisma"
+	case p.Attractiveness >= p.Strength:
+		return "attractiveness"
+	}
+	return "strength"
+}
+
+// fondness is -1 if the lead isn't drawn to a contestant, 1 if they are,
+// and 0 otherwise.
+func (p Profile) fondness(c Character, lead Character) int {
+	score := 0
+	if containsFold(p.Likes, c.Personality) {
+		score += 2
+	}
+	if containsFold(p.Dislikes, c.Personality) {
+		score -= 2
+	}
+	if strings.EqualFold(c.HairColor, p.HairColor) {
+		score++
+	}
+	if strings.EqualFold(c.EyeColor, p.EyeColor) {
+		score++
+	}
+	if h, ok := parseHeight(c.Height); ok {
+		if l, ok := parseHeight(lead.Height); ok && h != l && (h > l) == p.Taller {
+			score++
+		}
+	}
+	if statValue(c, p.favoriteStat()) >= 3 {
+		score++
+	}
+	switch {
+	case score >= 3:
+		return 1
+	case score <= -1:
+		return -1
+	}
+	return 0
+}
+
+// sway is delta as the lead feels it: fondness is added on, but never
+// enough to turn a gain into a loss or a loss into a gain.
+func (p Profile) sway(c Character, lead Character, delta int) int {
+	f := p.fondness(c, lead)
+	switch {
+	case delta > 0:
+		return max(delta+f, 0)
+	case delta < 0:
+		return min(delta+f, 0)
+	}
+	return 0
+}
+
+// react changes how the lead feels about a contestant, through the lead's
+// profile.
+func (state *GameState) react(name string, delta int) {
+	if c, ok := state.character(name); ok {
+		delta = state.Bachelor.Profile.sway(c, state.Bachelor, delta)
+	}
+	state.Relationship[name] += delta
+}
+
+// character looks up a contestant still in the house by name.
+func (state *GameState) character(name string) (Character, bool) {
+	for _, c := range state.Contestants {
+		if c.Name == name {
+			return c, true
+		}
+	}
+	return Character{}, false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(strings.TrimSpace(item), strings.TrimSpace(s)) {
+			return true
+		}
+	}
+	return false
+}
+
+var heightPattern = regexp.MustCompile(`(\d)\s*'\s*(\d{1,2})?`)
+
+// parseHeight reads a height like 5'11" into inches.
+func parseHeight(h string) (int, bool) {
+	m := heightPattern.FindStringSubmatch(h)
+	if m == nil {
+		return 0, false
+	}
+	feet, _ := strconv.Atoi(m[1])
+	inches, _ := strconv.Atoi(m[2])
+	return feet*12 + inches, true
+}
+
+// Hint topics, in the order they are listed for the player.
+var hintTopics = []Option{
+	{"\"What's your type?\"", "looks"},
+	{"\"What kind of person do you fall for?\"", "likes"},
+	{"\"Who do you just not click with?\"", "dislikes"},
+	{"\"What matters most to you in a partner?\"", "stat"},
+	{"\"What could you never get past?\"", "dealbreaker"},
+}
+
+var statHints = map[string]string{
+	"charisma":       "someone who can hold a real conversation",
+	"attractiveness": "chemistry, the kind you feel across a room",
+	"strength":       "someone who can keep up with {him}",
+}
+
+// hint is what the player knows about a topic.
+func (state *GameState) hint(topic string) string {
+	p := state.Bachelor.Profile
+	switch topic {
+	case "looks":
+		height := "shorter"
+		if p.Taller {
+			height = "taller"
+		}
+		return "{He} {has} a thing for " + strings.ToLower(p.HairColor) + " hair and " + strings.ToLower(p.EyeColor) + " eyes, on someone " + height + " than {him}."
+	case "likes":
+		return "{He} fall{s} for people who are " + joinOr(p.Likes) + "."
+	case "dislikes":
+		return "{He} just {doesn't} click with anyone " + joinOr(p.Dislikes) + "."
+	case "stat":
+		return "What matters most to {him} is " + statHints[p.favoriteStat()] + "."
+	}
+	return ""
+}
+
+// knownTastes lists everything the player has found out about the lead.
+func (state *GameState) knownTastes() []string {
+	var out []string
+	for _, t := range hintTopics {
+		if containsFold(state.Bachelor.Profile.Hints, t.Value) {
+			out = append(out, "• "+state.hint(t.Value))
+		}
+	}
+	for _, d := range state.knownDealbreakers() {
+		out = append(out, "• Dealbreaker: {he} "+d+".")
+	}
+	return out
+}
+
+// askLead lets the player ask the lead one question about what they're
+// looking for. A Charisma check decides whether the lead opens up.
+func (s *Season) askLead(title string) error {
+	state := s.State
+	topic, err := s.choose(Prompt{
+		Title: title,
+		Text:  "You find " + highlightBachelor(state.Bachelor.Name) + " alone on the terrace. This might be your only chance to find out what {he's} really looking for.",
+	}, "What do you ask?", hintTopics...)
+	if err != nil {
+		return err
+	}
+	if !s.statCheck(state.PlayerCharacter.Charisma, 10) {
+		return s.show(Event{Title: title, Text: "{He} smile{s} and change{s} the subject. You'll have to try again another night."})
+	}
+
+	var text string
+	if topic == "dealbreaker" {
+		profile := &state.Bachelor.Profile
+		for i, d := range profile.Dealbreakers {
+			if !d.Revealed {
+				profile.Dealbreakers[i].Revealed = true
+				text = "{He} think{s} about it for a long time. \"" + dealbreakerReveals[d.Trait] + "\""
+				break
+			}
+		}
+		if text == "" {
+			text = "\"I think you already know,\" {he} say{s}."
+		}
+	} else {
+		if !containsFold(state.Bachelor.Profile.Hints, topic) {
+			state.Bachelor.Profile.Hints = append(state.Bachelor.Profile.Hints, topic)
+		}
+		text = "{He} open{s} up a little. " + state.hint(topic)
+	}
+	return s.show(Event{Title: title, Text: text})
+}
+
+// joinOr joins words with "or".
+func joinOr(items []string) string {
+	lower := make([]string, len(items))
+	for i, item := range items {
+		lower[i] = strings.ToLower(item)
+	}
+	if len(lower) < 2 {
+		return strings.Join(lower, "")
+	}
+	return strings.Join(lower[:len(lower)-1], ", ") + " or " + lower[len(lower)-1]
+}
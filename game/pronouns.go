@@ -0,0 +1,127 @@
+package game
+
+import "strings"
+
+// Pronouns are the words the narrative uses for a character.
+type Pronouns struct {
+	Subject    string // he
+	Object     string // him
+	Possessive string // his
+	Reflexive  string // himself
+	// Plural pronouns take plural verbs: "they say", not "they says".
+	Plural bool
+}
+
+var (
+	HeHim    = Pronouns{"he", "him", "his", "himself", false}
+	SheHer   = Pronouns{"she", "her", "her", "herself", false}
+	TheyThem = Pronouns{"they", "them", "their", "themself", true}
+)
+
+// PronounOptions are the pronoun sets a player can pick from.
+var PronounOptions = []Option{
+	{"he/him", "he"},
+	{"she/her", "she"},
+	{"they/them", "they"},
+}
+
+// ParsePronouns maps a PronounOptions value to its pronouns.
+func ParsePronouns(s string) Pronouns {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "she", "she/her":
+		return SheHer
+	case "they", "they/them":
+		return TheyThem
+	}
+	return HeHim
+}
+
+// LeadTitles are the names the show can go by.
+var LeadTitles = []Option{
+	{"The Bachelor", "Bachelor"},
+	{"The Bachelorette", "Bachelorette"},
+}
+
+// ParseLeadTitle maps typed input to one of the LeadTitles values.
+func ParseLeadTitle(s string) string {
+	if strings.HasSuffix(strings.ToLower(strings.TrimSpace(s)), "ette") {
+		return "Bachelorette"
+	}
+	return "Bachelor"
+}
+
+// Narrate fills in the narrative placeholders for a season's lead. Text is
+// written with braces wherever the lead's pronouns or title appear:
+//
+//	{he} {him} {his} {himself} and capitalized {He} {His}
+//	{he's} {He's} for contractions
+//	{s} {es} {is} {has} {was} {doesn't} for verbs agreeing with {he}, and
+//	only {he}: a verb after the lead's name or title always takes the s
+//	{Bachelor} {bachelor} for the show's title
+//	{man} {guy} {brother} and capitalized {Man} {Guy} for the lead as a person
+//
+// so "{He} blush{es} and walk{s} away" reads "They blush and walk away"
+// for a lead who uses they/them.
+func Narrate(text, title string, p Pronouns) string {
+	if !strings.Contains(text, "{") {
+		return text
+	}
+	if p.Subject == "" {
+		p = HeHim
+	}
+	if title == "" {
+		title = "Bachelor"
+	}
+
+	s, es, is, has, was, doesnt, contraction := "s", "es", "is", "has", "was", "doesn't", "'s"
+	if p.Plural {
+		s, es, is, has, was, doesnt, contraction = "", "", "are", "have", "were", "don't", "'re"
+	}
+	man, guy, brother := "man", "guy", "brother"
+	switch p {
+	case SheHer:
+		man, guy, brother = "woman", "girl", "sister"
+	case TheyThem:
+		man, guy, brother = "person", "person", "sibling"
+	}
+
+	return strings.NewReplacer(
+		"{he}", p.Subject,
+		"{He}", capitalize(p.Subject),
+		"{him}", p.Object,
+		"{his}", p.Possessive,
+		"{His}", capitalize(p.Possessive),
+		"{himself}", p.Reflexive,
+		"{he's}", p.Subject+contraction,
+		"{He's}", capitalize(p.Subject)+contraction,
+		"{s}", s,
+		"{es}", es,
+		"{is}", is,
+		"{has}", has,
+		"{was}", was,
+		"{doesn't}", doesnt,
+		"{Bachelor}", title,
+		"{bachelor}", strings.ToLower(title),
+		"{man}", man,
+		"{guy}", guy,
+		"{brother}", brother,
+		"{Man}", capitalize(man),
+		"{Guy}", capitalize(guy),
+	).Replace(text)
+}
+
+// narrateAbout renders text about a contestant: {he}, {his} and the verbs
+// that agree with them follow the contestant's pronouns instead of the
+// lead's.
+func (state *GameState) narrateAbout(name, text string) string {
+	p := TheyThem
+	if c, ok := state.character(name); ok && c.Pronouns.Subject != "" {
+		p = c.Pronouns
+	}
+	return Narrate(text, state.LeadTitle, p)
+}
+
+// narrate renders text for this season's lead.
+func (state *GameState) narrate(text string) string {
+	return Narrate(text, state.LeadTitle, state.LeadPronouns)
+}
@@ -0,0 +1,195 @@
+package game
+
+import (
+	"strings"
+)
+
+const proposalTitle = "6. The Proposal"
+
+// scoreSpeech judges the player's final speech with the same lexicons as
+// their first words. Short speeches fall flat and rambling ones lose the
+// lead; what counts most is how warm it is, then saying the lead's name and
+// bringing up something the lead loves. Shouting it doesn't help.
+func scoreSpeech(speech string, lead Character) int {
+	a := Analyze(speech, lead)
+	score := 0
+	switch {
+	case a.Words < 5:
+		score -= 3
+	case a.Words > 120:
+		score--
+	default:
+		score++
+	}
+	score += 2 * min(max(a.Sentiment, -3), 3)
+	if containsFold(tokens(speech), strings.ToLower(lead.Name)) {
+		score += 2
+	}
+	if a.Hit != "" {
+		score += 2
+	}
+	if a.Shouting {
+		score -= 2
+	}
+	return score
+}
+
+// relationshipTrend is how far a contestant's score moved from their first
+// ceremony to their last.
+func (state *GameState) relationshipTrend(name string) int {
+	h := state.RelationshipHistory[name]
+	if len(h) < 2 {
+		return 0
+	}
+	return h[len(h)-1] - h[0]
+}
+
+func (s *Season) RunProposal() error {
+	if err := s.recap(); err != nil {
+		return err
+	}
+	state := s.State
+	p := state.PlayerCharacter
+	b := highlightBachelor(state.Bachelor.Name)
+
+	family, err := s.meetTheFamily()
+	if err != nil {
+		return err
+	}
+	date, err := s.finalDate()
+	if err != nil {
+		return err
+	}
+
+	opt, err := s.choose(Prompt{
+		Clear: true,
+		Title: proposalTitle,
+		Text:  "The morning of the proposal, you stand in front of the mirror in a Back Bay hotel room. Somewhere across the city, " + b + " is choosing a ring. A producer knocks and tells you the car is waiting. It's not too late to change your mind.",
+	}, "Do you get in the car?",
+		Option{"Get in the car", "stay"},
+		Option{"Walk away", "walk"},
+	)
+	if err != nil {
+		return err
+	}
+	if opt == "walk" {
+		state.Ending = EndingWalkedAway
+		s.record(MomentWalkout, p.Name, "", "You walked away on the morning of the proposal.")
+		err = s.show(Event{
+			Title: "The End",
+			Text:  "You hand the producer a letter for " + b + " and take the next train out of South Station. Maybe it was the right call. Maybe you'll wonder about it forever. Either way, it was your choice.",
+		})
+		if err != nil {
+			return err
+		}
+		return s.runnerUpTease()
+	}
+
+	a, err := s.ask(Prompt{
+		Title: proposalTitle,
+		Text:  "The car drops you at the end of a long dock on the Boston Harbor, lined with hundreds of roses. " + b + " is waiting at the end, looking more nervous than you've ever seen {him}. {He} nod{s} for you to speak first.",
+		Fields: []Field{{
+			Key:         "speech",
+			Title:       "What do you tell {him}?",
+			Placeholder: "e.g. From the moment I met you...",
+		}},
+	})
+	if err != nil {
+		return err
+	}
+	speech := strings.TrimSpace(a["speech"])
+	words := scoreSpeech(speech, state.Bachelor)
+
+	margin := 0
+	if h := state.RelationshipHistory[state.RunnerUp]; len(h) > 0 {
+		margin = state.Relationship[p.Name] - h[len(h)-1]
+	}
+	decision := margin + state.relationshipTrend(p.Name)/2 + family + date + words + s.Rand.IntN(9) - 4
+
+	quoted := "You take a breath and say nothing at all."
+	if speech != "" {
+		quoted = "You take a breath. \"" + speech + "\""
+	}
+	// saying nothing at all is an answer too
+	if speech != "" && decision > 3 {
+		state.Ending = EndingEngaged
+		s.record(MomentProposal, p.Name, "", "You got engaged to the {Bachelor} on the Boston Harbor.")
+		err = s.show(Event{
+			Title: "🌹 The Final Rose 🌹",
+			Text:  quoted + "\n\n" + b + " is quiet for a long moment. Then {he} reach{es} into {his} jacket, take{s} out a small velvet box, and get{s} down on one knee.\n\n\"I knew it the first night,\" {he} say{s}. \"Will you marry me?\"\n\nYou say yes. Of course you say yes. Congratulations, " + highlightPlayer(p.Name) + ". You found The One.",
+		})
+	} else {
+		state.Ending = EndingRejected
+		s.record(MomentProposal, p.Name, "", "The {Bachelor} couldn't give you a ring at the end of the dock.")
+		err = s.show(Event{
+			Title: "The End",
+			Text:  quoted + "\n\n" + b + " takes your hands and looks down at the dock. \"I wanted this to be you,\" {he} say{s}. \"I really did. But I can't give you a ring when I'm not sure.\"\n\n{He} walk{s} you back to the car {himself}. It's the kindest heartbreak you've ever had, which doesn't make it hurt any less.",
+		})
+	}
+	if err != nil {
+		return err
+	}
+	return s.runnerUpTease()
+}
+
+// meetTheFamily returns how much the lead's family approves of the player.
+func (s *Season) meetTheFamily() (int, error) {
+	c, err := s.conversation("meet-the-family", "")
+	if err != nil {
+		return 0, err
+	}
+	err = s.talk(Prompt{Clear: true, Title: proposalTitle}, c)
+	return c.Score, err
+}
+
+// finalDate returns how well the last date went.
+func (s *Season) finalDate() (int, error) {
+	p := s.State.PlayerCharacter
+	b := highlightBachelor(s.State.Bachelor.Name)
+
+	opt, err := s.choose(Prompt{
+		Clear: true,
+		Title: proposalTitle,
+		Text:  "It's your last date before the proposal. " + b + " lets you pick.",
+	}, "Where do you take {him}?",
+		Option{"A sunset sail around Boston Harbor", "sail"},
+		Option{"A private dinner at the top of the Prudential", "dinner"},
+		Option{"A late-night walk along the Charles", "charles"},
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	var stat int
+	var good, bad string
+	switch opt {
+	case "sail":
+		stat = p.Strength
+		good = "You handle the sails like a pro while {he} watch{es}, impressed. As the sun goes down, {he} say{s} {he} could get used to this."
+		bad = "The wind picks up and you spend most of the sail bailing water. {He} laugh{s} it off, but it's not the romantic evening you pictured."
+	case "dinner":
+		stat = p.Attractiveness
+		good = "Fifty-two floors up, the city glitters below you. {He} can't take {his} eyes off you long enough to look at it."
+		bad = "The food is perfect and the view is stunning, but the conversation never quite gets off the ground."
+	case "charles":
+		stat = p.Charisma
+		good = "You talk for hours, past the boathouses and all the way to Harvard Square. {He} tell{s} you things {he} {has} never told anyone."
+		bad = "It's cold, and the walk is quieter than you hoped. {He} seem{s} lost in thought."
+	}
+
+	if s.statCheck(stat, 10) {
+		return 3, s.show(Event{Title: proposalTitle, Text: good})
+	}
+	return 0, s.show(Event{Title: proposalTitle, Text: bad})
+}
+
+// runnerUpTease closes the season by announcing the next lead.
+func (s *Season) runnerUpTease() error {
+	if s.State.RunnerUp == "" {
+		return nil
+	}
+	return s.show(Event{
+		Title: "One More Thing...",
+		Text:  "Breaking news: the network has announced that this season's runner-up, " + highlightContestant(s.State.RunnerUp) + ", will be the one handing out the roses next season. See you there?",
+	})
+}
@@ -0,0 +1,23 @@
+package game
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// NewSeed picks a seed for a season when the player did not ask for one.
+func NewSeed() uint64 {
+	return uint64(time.Now().UnixNano())
+}
+
+// newSource builds the PCG stream every random roll in a season draws from.
+// The same seed always produces the same season.
+func newSource(seed uint64) *rand.PCG {
+	return rand.NewPCG(seed, seed^0x9e3779b97f4a7c15)
+}
+
+// NewRand returns a generator seeded the same way a Season's is, for modes
+// that roll dice outside a Season.
+func NewRand(seed uint64) *rand.Rand {
+	return rand.New(newSource(seed))
+}
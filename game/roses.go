@@ -0,0 +1,166 @@
+package game
+
+import "strings"
+
+// RoseKind is the kind of rose a contestant can be handed before a ceremony.
+type RoseKind int
+
+const (
+	RoseNone RoseKind = iota
+	// RoseFirstImpression goes to the lead's favourite on the first night.
+	RoseFirstImpression
+	// RoseGroupDate goes to the standout of a group date.
+	RoseGroupDate
+	// RoseOneOnOne ends a one-on-one date that went well.
+	RoseOneOnOne
+	// RoseTwoOnOne goes to whoever survives a two-on-one date.
+	RoseTwoOnOne
+)
+
+var roseNames = []string{"", "First Impression Rose", "Group Date Rose", "One-on-One Rose", "Two-on-One Rose"}
+
+func (k RoseKind) String() string {
+	if k < 0 || int(k) >= len(roseNames) {
+		return "unknown"
+	}
+	return roseNames[k]
+}
+
+// Rose is a rose handed out before a ceremony. Whoever holds one is safe
+// at the next ceremony.
+type Rose struct {
+	Kind   RoseKind `json:"kind"`
+	Holder string   `json:"holder"`
+	Phase  Phase    `json:"phase"`
+}
+
+// RoseOf is the rose a contestant holds going into the next ceremony.
+func (state *GameState) RoseOf(name string) RoseKind {
+	for _, r := range state.Roses {
+		if r.Holder == name {
+			return r.Kind
+		}
+	}
+	return RoseNone
+}
+
+// awardRose hands a contestant a rose and returns the announcement.
+// Contestants who already hold one keep it.
+func (s *Season) awardRose(kind RoseKind, name string) string {
+	if s.State.RoseOf(name) != RoseNone {
+		return ""
+	}
+	s.State.Roses = append(s.State.Roses, Rose{Kind: kind, Holder: name, Phase: s.State.Phase})
+	s.record(MomentRose, name, "", capitalize(s.nameOf(name))+" received the "+kind.String()+".")
+	if name == s.State.PlayerCharacter.Name {
+		return "🌹 You receive the " + kind.String() + "! You're safe at the next ceremony."
+	}
+	return "🌹 " + highlightContestant(name) + " receives the " + kind.String() + " and is safe at the next ceremony."
+}
+
+// splitCeremony sorts the house and splits it into who stays and who goes.
+// The bottom num are cut, skipping anyone holding a rose unless there are
+// too few contestants without one. Both halves stay in score order.
+func (state *GameState) splitCeremony(num int) (top, bottom []Character) {
+	SortByRelationship(state)
+	cut := map[string]bool{}
+	for pass := 0; pass < 2 && len(cut) < num; pass++ {
+		for i := len(state.Contestants) - 1; i >= 0 && len(cut) < num; i-- {
+			c := state.Contestants[i]
+			if cut[c.Name] || (pass == 0 && state.RoseOf(c.Name) != RoseNone) {
+				continue
+			}
+			cut[c.Name] = true
+		}
+	}
+	for _, c := range state.Contestants {
+		if cut[c.Name] {
+			bottom = append(bottom, c)
+		} else {
+			top = append(top, c)
+		}
+	}
+	return top, bottom
+}
+
+// groupDateRose gives a rose to whoever gained the most ground since the
+// before snapshot was taken, and returns the announcement.
+func (s *Season) groupDateRose(before map[string]int) string {
+	best, bestGain := "", 0
+	for _, c := range s.State.Contestants {
+		if s.State.RoseOf(c.Name) != RoseNone {
+			continue
+		}
+		if gain := s.State.Relationship[c.Name] - before[c.Name]; gain > bestGain {
+			best, bestGain = c.Name, gain
+		}
+	}
+	if best == "" {
+		return ""
+	}
+	return s.awardRose(RoseGroupDate, best)
+}
+
+// snapshot copies the current standings so a session can measure who
+// gained the most.
+func (state *GameState) snapshot() map[string]int {
+	m := make(map[string]int, len(state.Relationship))
+	for k, v := range state.Relationship {
+		m[k] = v
+	}
+	return m
+}
+
+// runOneOnOne sends one of the top contestants on a one-on-one date, and
+// returns who went. It ends with a rose if the date goes well. The player's
+// date is one of the content pack's dates.
+func (s *Season) runOneOnOne(title string) (string, error) {
+	state := s.State
+	SortByRelationship(state)
+	var picks []Character
+	for _, c := range state.Contestants {
+		if state.RoseOf(c.Name) == RoseNone {
+			picks = append(picks, c)
+		}
+		if len(picks) == 3 {
+			break
+		}
+	}
+	if len(picks) == 0 {
+		return "", nil
+	}
+	c := picks[s.Rand.IntN(len(picks))]
+	s.record(MomentDate, c.Name, "", capitalize(s.nameOf(c.Name))+" went on a one-on-one with the {Bachelor}.")
+	if c.IsPlayer {
+		return c.Name, s.playerOneOnOne(title)
+	}
+
+	text := []string{"A date card arrives for " + highlightContestant(c.Name) + ". The rest of the house watches " + s.State.narrateAbout(c.Name, "{him}") + " leave for a day alone with the {Bachelor}."}
+	if s.check(c.Name, c.Charisma+c.Attractiveness, 14) {
+		s.adjust(c.Name, 2)
+		text = append(text, s.awardRose(RoseOneOnOne, c.Name))
+	} else {
+		s.adjust(c.Name, -1)
+		text = append(text, highlightContestant(c.Name)+" comes home without a rose.")
+	}
+	return c.Name, s.show(Event{Title: title, Text: strings.Join(text, "\n\n")})
+}
+
+// playerOneOnOne plays out the player's one-on-one date, one of the content
+// pack's dates, and gives the player the rose if it goes well.
+func (s *Season) playerOneOnOne(title string) error {
+	dates := content.Dates()
+	date := dates[s.Rand.IntN(len(dates))]
+	err := s.show(Event{
+		Title: title,
+		Text:  "A date card arrives with your name on it. You and the {Bachelor} spend the whole day together, just the two of you.",
+	})
+	if err != nil {
+		return err
+	}
+	score, err := s.RunDialogue(title, date.ID)
+	if err != nil || score <= 0 {
+		return err
+	}
+	return s.show(Event{Title: title, Text: s.awardRose(RoseOneOnOne, s.State.PlayerCharacter.Name)})
+}
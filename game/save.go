@@ -0,0 +1,195 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// SaveVersion is bumped whenever the save format changes incompatibly.
+// Version 2 added PhaseHometowns, version 3 everyone's mood, energy and
+// stress, version 4 moved the lead's dealbreakers into their Profile, and
+// version 5 gave every character their pronouns.
+const SaveVersion = 5
+
+type saveFile struct {
+	Version int       `json:"version"`
+	Phase   Phase     `json:"phase"`
+	RNG     []byte    `json:"rng"`
+	State   GameState `json:"state"`
+}
+
+// DefaultSavePath is where seasons are saved unless told otherwise.
+func DefaultSavePath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "bachelor-sim", "season.json"), nil
+}
+
+// HasSave reports whether a saved season exists at path.
+func HasSave(path string) bool {
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// Save writes the season, including the position of its random stream, so
+// that Load can pick it up exactly where it stopped.
+func (s *Season) Save(path string) error {
+	rng, err := s.src.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(saveFile{
+		Version: SaveVersion,
+		Phase:   s.State.Phase,
+		RNG:     rng,
+		State:   *s.State,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+func readSave(path string) (saveFile, error) {
+	var f saveFile
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return f, err
+	}
+	if err := json.Unmarshal(data, &f); err != nil {
+		return f, fmt.Errorf("reading save %s: %w", path, err)
+	}
+	if f.Version == 1 {
+		// version 1 saves predate hometowns, which now come before the
+		// fantasy suites: a save about to play the fantasy suites plays
+		// hometowns first, and anything later moves up one
+		if f.Phase > PhaseHometowns {
+			f.Phase++
+		}
+		f.Version = 2
+	}
+	if f.Version == 2 {
+		// version 2 saves predate everyone's mood, energy and stress
+		f.State.initMeters()
+		f.Version = 3
+	}
+	if f.Version == 3 {
+		// version 3 saves may still keep the lead's dealbreakers on the
+		// lead rather than in their Profile
+		var old struct {
+			State struct {
+				Bachelor struct {
+					Dealbreakers []Dealbreaker
+				}
+			}
+		}
+		if err := json.Unmarshal(data, &old); err != nil {
+			return f, fmt.Errorf("reading save %s: %w", path, err)
+		}
+		if p := &f.State.Bachelor.Profile; len(p.Dealbreakers) == 0 {
+			p.Dealbreakers = old.State.Bachelor.Dealbreakers
+		}
+		f.Version = 4
+	}
+	if f.Version == 4 {
+		// version 4 saves don't have pronouns for anyone but the lead,
+		// and every other contestant was she/her
+		f.State.backfillPronouns()
+		f.Version = 5
+	}
+	if f.Version != SaveVersion {
+		return f, fmt.Errorf("save %s has version %d, expected %d", path, f.Version, SaveVersion)
+	}
+	return f, nil
+}
+
+// backfillPronouns gives pronouns to characters saved without them.
+func (state *GameState) backfillPronouns() {
+	if state.PlayerCharacter.Pronouns.Subject == "" {
+		state.PlayerCharacter.Pronouns = TheyThem
+	}
+	if state.Bachelor.Pronouns.Subject == "" {
+		state.Bachelor.Pronouns = state.LeadPronouns
+	}
+	for i, c := range state.Contestants {
+		switch {
+		case c.Pronouns.Subject != "":
+		case c.IsPlayer:
+			state.Contestants[i].Pronouns = state.PlayerCharacter.Pronouns
+		default:
+			state.Contestants[i].Pronouns = SheHer
+		}
+	}
+}
+
+// Load replaces the season's state and random stream with a saved one.
+func (s *Season) Load(path string) error {
+	f, err := readSave(path)
+	if err != nil {
+		return err
+	}
+	if err := s.src.UnmarshalBinary(f.RNG); err != nil {
+		return fmt.Errorf("reading save %s: %w", path, err)
+	}
+
+	if f.State.Relationship == nil {
+		f.State.Relationship = make(map[string]int)
+	}
+	if f.State.RelationshipHistory == nil {
+		f.State.RelationshipHistory = make(map[string][]int)
+	}
+	if f.State.Affinities == nil {
+		f.State.Affinities = make(map[string]map[string]int)
+	}
+	if f.State.HometownApproval == nil {
+		f.State.HometownApproval = make(map[string]int)
+	}
+	if f.State.StatHistory == nil {
+		f.State.StatHistory = make(map[string][]StatLine)
+	}
+	if f.State.Flags == nil {
+		f.State.Flags = make(map[string]bool)
+	}
+	f.State.Phase = f.Phase
+	*s.State = f.State
+	return nil
+}
+
+// autosave persists the season if saving is enabled.
+func (s *Season) autosave() error {
+	if s.SavePath == "" || s.Done() {
+		return nil
+	}
+	if err := s.Save(s.SavePath); err != nil {
+		return fmt.Errorf("autosave: %w", err)
+	}
+	return nil
+}
+
+// clearSave removes the save of a finished season so it cannot be continued.
+func (s *Season) clearSave() error {
+	if s.SavePath == "" {
+		return nil
+	}
+	err := os.Remove(s.SavePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,63 @@
+package game
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+)
+
+func TestReadSaveMigratesVersion1Phases(t *testing.T) {
+	// version 1 had no hometowns, so its fantasy suites sat where
+	// hometowns are now
+	tests := []struct {
+		name  string
+		saved int
+		want  Phase
+	}{
+		{"berkshires", int(PhaseBerkshires), PhaseBerkshires},
+		{"fantasy suites", int(PhaseHometowns), PhaseHometowns},
+		{"proposal", int(PhaseHometowns) + 1, PhaseProposal},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "season.json")
+			data := `{"version": 1, "phase": ` + strconv.Itoa(tc.saved) + `, "state": {}}`
+			if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			f, err := readSave(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if f.Phase != tc.want {
+				t.Errorf("phase = %v, want %v", f.Phase, tc.want)
+			}
+		})
+	}
+}
+
+func TestReadSaveBackfillsPronouns(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "season.json")
+	data := `{"version": 4, "phase": 5, "state": {
+		"PlayerCharacter": {"Name": "Ellory", "IsPlayer": true},
+		"Contestants": [{"Name": "Ellory", "IsPlayer": true}, {"Name": "Alice"}],
+		"LeadPronouns": {"Subject": "he", "Object": "him", "Possessive": "his", "Reflexive": "himself"}
+	}}`
+	if err := os.WriteFile(path, []byte(data), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	f, err := readSave(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := f.State.Contestants[1].Pronouns; got != SheHer {
+		t.Errorf("contestant pronouns = %v, want %v", got, SheHer)
+	}
+	if got := f.State.Contestants[0].Pronouns; got != TheyThem {
+		t.Errorf("player pronouns = %v, want %v", got, TheyThem)
+	}
+	if got := f.State.Bachelor.Pronouns; got != HeHim {
+		t.Errorf("lead pronouns = %v, want %v", got, HeHim)
+	}
+}
@@ -0,0 +1,134 @@
+package game
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// ErrScriptExhausted is returned when a Script is asked for more answers
+// than it holds.
+var ErrScriptExhausted = errors.New("script ran out of answers")
+
+// Script is a Frontend that plays a season from a fixed list of answers,
+// one per prompt field in the order they are asked, and records a plain
+// text transcript of everything it was shown. Events need no answer; they
+// are acknowledged automatically like an Enter press.
+//
+// An empty answer keeps the default: the first option of a selection or an
+// empty string for free text. A selection answer may be an option's value
+// or its label, and the transcript records the label.
+type Script struct {
+	Answers []string
+
+	next       int
+	transcript strings.Builder
+}
+
+func NewScript(answers ...string) *Script {
+	return &Script{Answers: answers}
+}
+
+// LoadScript reads answers one per line. Lines starting with # are
+// comments; a blank line is an Enter press that keeps the default.
+func LoadScript(r io.Reader) (*Script, error) {
+	var answers []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+		answers = append(answers, line)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return NewScript(answers...), nil
+}
+
+// Remaining is the number of answers not yet used.
+func (sc *Script) Remaining() int {
+	return len(sc.Answers) - sc.next
+}
+
+// Transcript returns everything shown and answered so far, without colors.
+func (sc *Script) Transcript() string {
+	return sc.transcript.String()
+}
+
+func (sc *Script) Show(ev Event) error {
+	sc.writeHeader(ev.Phase, ev.Title)
+	if text := EventText(ev); text != "" {
+		sc.transcript.WriteString(StripColors(text) + "\n")
+	}
+	return nil
+}
+
+func (sc *Script) Ask(p Prompt) (Answers, error) {
+	sc.writeHeader(p.Phase, p.Title)
+	if p.Text != "" {
+		sc.transcript.WriteString(StripColors(p.Text) + "\n")
+	}
+
+	answers := Answers{}
+	for _, f := range p.Fields {
+		if sc.next >= len(sc.Answers) {
+			return nil, fmt.Errorf("%w at %q", ErrScriptExhausted, f.Title)
+		}
+		raw := sc.Answers[sc.next]
+		sc.next++
+
+		value, label, err := matchAnswer(f, raw)
+		if err != nil {
+			return nil, err
+		}
+		answers[f.Key] = value
+		sc.transcript.WriteString("? " + f.Title + " > " + StripColors(label) + "\n")
+	}
+	return answers, nil
+}
+
+func (sc *Script) writeHeader(phase Phase, title string) {
+	if title == "" {
+		sc.transcript.WriteString("\n[" + phase.String() + "]\n")
+		return
+	}
+	sc.transcript.WriteString("\n[" + phase.String() + "] " + StripColors(title) + "\n")
+}
+
+// matchAnswer finds the value an answer picks, and how to show it.
+func matchAnswer(f Field, raw string) (value, label string, err error) {
+	if len(f.Options) == 0 {
+		return raw, raw, nil
+	}
+	if raw == "" {
+		return f.Options[0].Value, f.Options[0].Label, nil
+	}
+	for _, o := range f.Options {
+		if o.Value == raw || o.Label == raw {
+			return o.Value, o.Label, nil
+		}
+	}
+	return "", "", fmt.Errorf("script answer %q is not an option for %q", raw, f.Title)
+}
+
+// EventText is the full text of an event, with its leaderboard, as every
+// front-end presents it.
+func EventText(ev Event) string {
+	text := SpokenText(ev.Speaker, ev.Text)
+	if len(ev.Standings) == 0 {
+		return text
+	}
+	return "LEADERBOARD:\n" + FormatLeaderboard(ev.Standings) + "\n" + text
+}
+
+var colorCodes = regexp.MustCompile("\033\\[[0-9;]*m")
+
+// StripColors removes the terminal color codes the narrative is written with.
+func StripColors(s string) string {
+	return colorCodes.ReplaceAllString(s, "")
+}
@@ -1,5 +1,16 @@
 package game
 
+// Ending records how the season finished for the player.
+type Ending int
+
+const (
+    EndingNone Ending = iota
+    EndingEliminated
+    EndingEngaged
+    EndingRejected
+    EndingWalkedAway
+)
+
 type GameState struct {
     PlayerCharacter Character
     Bachelor        Character
@@ -7,11 +18,49 @@ type GameState struct {
     Episode         int
     Relationship    map[string]int
     Eliminated      []string
+    Phase           Phase
+    Seed            uint64
+    // RelationshipHistory holds each contestant's score at every ceremony
+    // they took part in.
+    RelationshipHistory map[string][]int
+    RunnerUp            string
+    Ending              Ending
+    // LeadTitle is what the show calls its lead, "Bachelor" or
+    // "Bachelorette", and LeadPronouns are how the narrative refers to them.
+    LeadTitle           string
+    LeadPronouns        Pronouns
+    // Affinities is how every pair of contestants feel about each other.
+    Affinities          map[string]map[string]int
+    // Moves are the decisions the other contestants made, in order.
+    Moves               []Move
+    // Roses are the roses handed out since the last ceremony.
+    Roses               []Rose
+    // HometownApproval is how each finalist's family took to the lead.
+    HometownApproval    map[string]int
+    // StatHistory is each contestant's stats at the start of the season
+    // and after every downtime.
+    StatHistory         map[string][]StatLine
+    // Flags are what dialogues have set, for later dialogues to check.
+    Flags               map[string]bool
+    // Log is everything worth remembering about the season, in order.
+    Log                 []Moment
 }
 
 func NewGameState() GameState {
     return GameState{
         Episode:      1,
+        LeadTitle:    "Bachelor",
+        LeadPronouns: HeHim,
         Relationship: make(map[string]int),
+        RelationshipHistory: make(map[string][]int),
+        Affinities: make(map[string]map[string]int),
+        HometownApproval: make(map[string]int),
+        StatHistory: make(map[string][]StatLine),
+        Flags: make(map[string]bool),
     }
 }
+
+// PlayerEliminated reports whether the player has been sent home.
+func (state *GameState) PlayerEliminated() bool {
+    return isEliminated(state, state.PlayerCharacter.Name)
+}
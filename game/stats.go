@@ -0,0 +1,63 @@
+package game
+
+// statCheck rolls a d20, adds the stat and beats the difficulty or not.
+func (s *Season) statCheck(stat, difficulty int) bool {
+	return s.Rand.IntN(20)+stat > difficulty
+}
+
+// adjust moves a contestant's standing with the Bachelor.
+func (s *Season) adjust(name string, delta int) {
+	s.State.react(name, delta)
+}
+
+// groupRolls plays out an activity for every contestant in a group, so the
+// rest of the field keeps moving while the player is busy. It returns the
+// contestants who caught the Bachelor's eye. Allies in the group help each
+// other out, the group grows closer, and the ones left behind resent the
+// standouts. Everyone comes back a little tired, and the standouts in a
+// better mood than the rest.
+func (s *Season) groupRolls(group []Character, stat func(Character) int, difficulty int) []string {
+	var standouts, rest []string
+	for _, c := range group {
+		if s.check(c.Name, stat(c)+s.State.allyBonus(c.Name, group), difficulty) {
+			s.adjust(c.Name, 2)
+			s.State.feel(c.Name, 1, -1, 0)
+			standouts = append(standouts, c.Name)
+		} else {
+			s.State.feel(c.Name, -1, -1, 0)
+			rest = append(rest, c.Name)
+		}
+	}
+	s.State.bondGroup(characterNames(group), 1)
+	for _, a := range standouts {
+		for _, b := range rest {
+			s.State.bond(a, b, -1)
+		}
+	}
+	return standouts
+}
+
+func charisma(c Character) int       { return c.Charisma }
+func attractiveness(c Character) int { return c.Attractiveness }
+func strength(c Character) int       { return c.Strength }
+
+// statValue is one of a character's stats by name.
+func statValue(c Character, stat string) int {
+	switch stat {
+	case "charisma":
+		return c.Charisma
+	case "attractiveness":
+		return c.Attractiveness
+	case "strength":
+		return c.Strength
+	}
+	return 0
+}
+
+func characterNames(group []Character) []string {
+	names := make([]string, 0, len(group))
+	for _, c := range group {
+		names = append(names, c.Name)
+	}
+	return names
+}
@@ -0,0 +1,247 @@
+package game
+
+import (
+	"math/rand/v2"
+	"strconv"
+	"strings"
+)
+
+// Strategy is how a contestant plays the game when the player isn't
+// looking.
+type Strategy int
+
+const (
+	// StrategySweetheart is here for the right reasons and spends every
+	// spare minute with the lead.
+	StrategySweetheart Strategy = iota
+	// StrategySchemer plays the numbers: the activity that suits their best
+	// stat, a quiet rumor about whoever is on top, and a chat with the lead
+	// only when they're in trouble.
+	StrategySchemer
+	// StrategyWallflower keeps out of the way and rarely makes a move, but
+	// when they do it comes from the heart.
+	StrategyWallflower
+	// StrategyVillain picks fights, especially with rivals.
+	StrategyVillain
+)
+
+var strategyNames = []string{"sweetheart", "schemer", "wallflower", "villain"}
+
+func (st Strategy) String() string {
+	if st < 0 || int(st) >= len(strategyNames) {
+		return "unknown"
+	}
+	return strategyNames[st]
+}
+
+// personalityStrategies maps the personalities in the default pack to the
+// strategy they play. Anything else, including personalities from user
+// packs, plays the sweetheart.
+var personalityStrategies = map[string]Strategy{
+	"ambitious":   StrategySchemer,
+	"competitive": StrategySchemer,
+	"mysterious":  StrategySchemer,
+	"charismatic": StrategySchemer,
+	"stylish":     StrategySchemer,
+	"shy":         StrategyWallflower,
+	"reserved":    StrategyWallflower,
+	"awkward":     StrategyWallflower,
+	"thoughtful":  StrategyWallflower,
+	"sensitive":   StrategyWallflower,
+	"serious":     StrategyWallflower,
+	"jealous":     StrategyVillain,
+	"dramatic":    StrategyVillain,
+	"cynical":     StrategyVillain,
+	"intense":     StrategyVillain,
+	"bold":        StrategyVillain,
+}
+
+// StrategyFor is the strategy a contestant with a personality plays.
+func StrategyFor(personality string) Strategy {
+	return personalityStrategies[strings.ToLower(strings.TrimSpace(personality))]
+}
+
+// Move is one decision a contestant made during a session.
+type Move struct {
+	Phase  Phase  `json:"phase"`
+	Name   string `json:"name"`
+	Action string `json:"action"`
+	Target string `json:"target,omitempty"`
+	// Text is how the move looked from the house.
+	Text string `json:"text"`
+}
+
+// Activities at Cape Cod, in the order AssignToGroups returns them.
+const (
+	activityHike = iota
+	activityVolleyball
+	activityRelax
+)
+
+// chooseActivity is where a contestant spends the day at Cape Cod.
+func chooseActivity(rng *rand.Rand, c Character) int {
+	switch c.Strategy {
+	case StrategySweetheart:
+		// the lead is watching from the balcony over the beach
+		if rng.IntN(3) > 0 {
+			return activityRelax
+		}
+	case StrategySchemer:
+		if c.Strength > c.Attractiveness {
+			return activityHike + rng.IntN(2)
+		}
+		return activityRelax
+	case StrategyWallflower:
+		if rng.IntN(3) > 0 {
+			return activityHike
+		}
+	case StrategyVillain:
+		if rng.IntN(3) > 0 {
+			return activityVolleyball
+		}
+	}
+	return rng.IntN(3)
+}
+
+// maxShownMoves is how many moves the player hears about each session; the
+// rest are only logged.
+const maxShownMoves = 8
+
+// runStrategies lets every contestant but the player make their move for
+// the session, and shows the player what happened around the house.
+func (s *Season) runStrategies(title string) error {
+	state := s.State
+	SortByRelationship(state)
+	// moves against the player are listed first
+	var lines, others []string
+	for i, c := range state.Contestants {
+		if c.IsPlayer {
+			continue
+		}
+		m, ok := s.decide(c, i)
+		if !ok {
+			continue
+		}
+		m.Phase = state.Phase
+		m.Name = c.Name
+		state.Moves = append(state.Moves, m)
+		if recap := s.moveRecap(m); recap != "" {
+			s.record(MomentMove, c.Name, m.Target, recap)
+		}
+		if m.Target == state.PlayerCharacter.Name {
+			lines = append(lines, "• "+m.Text)
+		} else {
+			others = append(others, "• "+m.Text)
+		}
+	}
+	lines = append(lines, others...)
+	if len(lines) == 0 {
+		return nil
+	}
+	text := "Meanwhile, around the house:\n\n"
+	if len(lines) > maxShownMoves {
+		text += strings.Join(lines[:maxShownMoves], "\n") + "\n\n...and " + strconv.Itoa(len(lines)-maxShownMoves) + " more contestants made their moves."
+	} else {
+		text += strings.Join(lines, "\n")
+	}
+	return s.show(Event{Title: title, Text: text})
+}
+
+// moveRecap is how a move against someone reads in a recap, or "" for
+// moves that don't involve anyone else.
+func (s *Season) moveRecap(m Move) string {
+	who, target := highlightContestant(m.Name), s.nameOf(m.Target)
+	switch m.Action {
+	case "rumor":
+		return who + " spread a rumor about " + target + "."
+	case "confront":
+		return who + " confronted " + target + " in front of everyone."
+	}
+	return ""
+}
+
+// decide picks a contestant's move given their rank in the house, or
+// reports that they sat this one out.
+func (s *Season) decide(c Character, rank int) (Move, bool) {
+	state := s.State
+	onBubble := rank >= len(state.Contestants)*2/3
+	who := highlightContestant(c.Name)
+
+	switch c.Strategy {
+	case StrategySweetheart:
+		if s.Rand.IntN(2) == 0 {
+			return Move{}, false
+		}
+		if s.statCheck(c.Charisma, 12) {
+			s.adjust(c.Name, 1)
+			return Move{Action: "pull-aside", Text: who + " pulls the {Bachelor} aside for a long talk by the fire."}, true
+		}
+		s.adjust(c.Name, -1)
+		return Move{Action: "pull-aside", Text: who + " interrupts the {Bachelor} for the third time tonight and gets a polite smile."}, true
+
+	case StrategySchemer:
+		if onBubble {
+			if s.statCheck(c.Charisma, 10) {
+				s.adjust(c.Name, 2)
+				return Move{Action: "pull-aside", Text: who + " knows the numbers and makes a well-timed play for the {Bachelor}."}, true
+			}
+			return Move{Action: "pull-aside", Text: who + " tries to grab the {Bachelor}, but the timing is all wrong."}, true
+		}
+		target := s.frontRunner(c.Name)
+		if target == "" {
+			return Move{}, false
+		}
+		state.bond(c.Name, target, -1)
+		state.feel(target, 0, 0, 1)
+		if s.Rand.IntN(3) == 0 {
+			s.adjust(c.Name, -2)
+			state.bond(c.Name, target, -2)
+			return Move{Action: "rumor", Target: target, Text: who + " starts a rumor about " + s.nameOf(target) + " and gets caught."}, true
+		}
+		s.adjust(target, -1)
+		return Move{Action: "rumor", Target: target, Text: who + " quietly spreads a rumor about " + s.nameOf(target) + "."}, true
+
+	case StrategyWallflower:
+		if s.Rand.IntN(4) > 0 {
+			return Move{}, false
+		}
+		s.adjust(c.Name, 2)
+		return Move{Action: "pull-aside", Text: who + " finally works up the nerve to talk to the {Bachelor}, and it's the sweetest moment of the night."}, true
+
+	case StrategyVillain:
+		target := s.frontRunner(c.Name)
+		if rivals := state.Rivals(c.Name); len(rivals) > 0 {
+			target = rivals[0]
+		}
+		if target == "" {
+			return Move{}, false
+		}
+		state.bond(c.Name, target, -2)
+		state.feel(target, -1, 0, 2)
+		if s.statCheck(c.Charisma, 12) {
+			s.adjust(target, -2)
+			return Move{Action: "confront", Target: target, Text: who + " confronts " + s.nameOf(target) + " in front of everyone, and it lands."}, true
+		}
+		s.adjust(c.Name, -2)
+		return Move{Action: "confront", Target: target, Text: who + " picks a fight with " + s.nameOf(target) + " and comes off badly."}, true
+	}
+	return Move{}, false
+}
+
+// frontRunner is the best-placed contestant name isn't allied with.
+func (s *Season) frontRunner(name string) string {
+	for _, c := range s.State.Contestants {
+		if c.Name != name && s.State.Affinity(name, c.Name) < allyThreshold {
+			return c.Name
+		}
+	}
+	return ""
+}
+
+// nameOf highlights a contestant, or says "you" for the player.
+func (s *Season) nameOf(name string) string {
+	if name == s.State.PlayerCharacter.Name {
+		return "you"
+	}
+	return highlightContestant(name)
+}
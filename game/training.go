@@ -0,0 +1,257 @@
+package game
+
+import (
+	"strconv"
+	"strings"
+)
+
+// statCap is as high as training can take a stat.
+const statCap = 5
+
+// Time the player and everyone else gets between episodes, in blocks.
+const (
+	playerBlocks = 3
+	npcBlocks    = 2
+)
+
+// StatLine is a character's stats at one point in the season.
+type StatLine struct {
+	Label          string `json:"label"`
+	Charisma       int    `json:"charisma"`
+	Attractiveness int    `json:"attractiveness"`
+	Strength       int    `json:"strength"`
+}
+
+// recordStats adds a line to every contestant's stat history.
+func (state *GameState) recordStats(label string) {
+	if state.StatHistory == nil {
+		state.StatHistory = make(map[string][]StatLine)
+	}
+	for _, c := range state.Contestants {
+		state.StatHistory[c.Name] = append(state.StatHistory[c.Name], StatLine{label, c.Charisma, c.Attractiveness, c.Strength})
+	}
+}
+
+// statPtr is one of a character's stats by name, for training.
+func statPtr(c *Character, stat string) *int {
+	switch stat {
+	case "charisma":
+		return &c.Charisma
+	case "attractiveness":
+		return &c.Attractiveness
+	case "strength":
+		return &c.Strength
+	}
+	return nil
+}
+
+// train works on one of a character's stats and reports whether it went up.
+// The higher the stat, the harder it is to raise, and every earlier block
+// spent on the same stat this downtime makes it harder still.
+func (s *Season) train(c *Character, stat string, reps int) bool {
+	v := statPtr(c, stat)
+	if v == nil || *v >= statCap {
+		return false
+	}
+	if s.Rand.IntN(6)-reps < *v {
+		return false
+	}
+	*v++
+	return true
+}
+
+// trainingFor is the stat a contestant works on between episodes.
+func trainingFor(c Character) string {
+	switch c.Strategy {
+	case StrategySweetheart:
+		return "attractiveness"
+	case StrategySchemer:
+		// make the most of what already works
+		best := "charisma"
+		if c.Attractiveness > c.Charisma {
+			best = "attractiveness"
+		}
+		if c.Strength > statValue(c, best) {
+			best = "strength"
+		}
+		return best
+	case StrategyWallflower:
+		return "charisma"
+	}
+	return "strength"
+}
+
+// Training activities the player can spend a block on.
+var trainingOptions = []Option{
+	{"Hit the hotel gym (Strength)", "strength"},
+	{"Book time with the stylist (Attractiveness)", "attractiveness"},
+	{"Hang out with the house (Charisma)", "charisma"},
+	{"Sleep in", "rest"},
+	{"Look at your profile", "profile"},
+}
+
+var trainingWins = map[string]string{
+	"strength":       "All that time in the gym is paying off.",
+	"attractiveness": "The new look is a hit. Heads turn when you walk in.",
+	"charisma":       "You're getting better at holding a room.",
+}
+
+var trainingMisses = map[string]string{
+	"strength":       "You're sore, but no stronger yet.",
+	"attractiveness": "The new look doesn't quite land.",
+	"charisma":       "You hang around the kitchen island, but the conversation never really gets going.",
+}
+
+// RunDowntime gives everyone a few days between episodes to work on
+// themselves. The player spends their time block by block; everyone else
+// trains according to their strategy.
+func (s *Season) RunDowntime(title string) error {
+	state := s.State
+	err := s.show(Event{
+		Clear: true,
+		Title: title,
+		Text:  "There are a few days off before the next date. How you spend them is up to you.",
+	})
+	if err != nil {
+		return err
+	}
+
+	reps := map[string]int{}
+	for used := 0; used < playerBlocks && !state.PlayerEliminated(); {
+		left := strconv.Itoa(playerBlocks-used) + " blocks"
+		if playerBlocks-used == 1 {
+			left = "1 block"
+		}
+		opt, err := s.choose(Prompt{
+			Title: title,
+			Text:  "You have " + left + " of free time left.",
+		}, "What do you do?", trainingOptions...)
+		if err != nil {
+			return err
+		}
+		if opt == "profile" {
+			if err := s.showProfile(title); err != nil {
+				return err
+			}
+			continue
+		}
+		used++
+		if opt == "rest" {
+			state.feel(state.PlayerCharacter.Name, 1, 3, -2)
+			if err := s.show(Event{Title: title, Text: "You sleep in. Nobody here gets enough sleep."}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		text := s.playerTraining(opt, reps[opt])
+		reps[opt]++
+		state.feel(state.PlayerCharacter.Name, 0, -1, -1)
+		if err := s.show(Event{Title: title, Text: text}); err != nil {
+			return err
+		}
+	}
+
+	var gains []string
+	for i := range state.Contestants {
+		c := &state.Contestants[i]
+		if c.IsPlayer {
+			continue
+		}
+		stat := trainingFor(*c)
+		gained := false
+		for rep := 0; rep < npcBlocks; rep++ {
+			if s.train(c, stat, rep) {
+				gained = true
+			}
+		}
+		// everyone else splits their time between training and resting
+		state.feel(c.Name, 0, 2, -2)
+		if gained {
+			gains = append(gains, "• "+highlightContestant(c.Name)+" has been working on "+stat+": up to "+strconv.Itoa(statValue(*c, stat))+".")
+		}
+	}
+	state.recordStats("After " + (state.Phase - 1).Title())
+	if len(gains) == 0 {
+		return nil
+	}
+	if len(gains) > maxShownMoves {
+		gains = append(gains[:maxShownMoves], "...and "+strconv.Itoa(len(gains)-maxShownMoves)+" more.")
+	}
+	return s.show(Event{Title: title, Text: "The rest of the house hasn't been sitting around either:\n\n" + strings.Join(gains, "\n")})
+}
+
+// playerTraining spends one block of the player's time on a stat and
+// returns what happened.
+func (s *Season) playerTraining(stat string, reps int) string {
+	state := s.State
+	var text string
+	for i := range state.Contestants {
+		c := &state.Contestants[i]
+		if !c.IsPlayer {
+			continue
+		}
+		switch {
+		case *statPtr(c, stat) >= statCap:
+			text = "You're already at your peak. There's only so much more you can do."
+		case s.train(c, stat, reps):
+			text = trainingWins[stat] + " " + capitalize(stat) + " up to " + strconv.Itoa(*statPtr(c, stat)) + "."
+		default:
+			text = trainingMisses[stat]
+		}
+		if stat == "charisma" {
+			if friend := s.randomHousemate(c.Name); friend != "" {
+				state.bond(c.Name, friend, 1)
+				text += " You end up talking with " + highlightContestant(friend) + " for hours."
+			}
+		}
+		state.PlayerCharacter = *c
+	}
+	return text
+}
+
+// randomHousemate is anyone in the house other than name.
+func (s *Season) randomHousemate(name string) string {
+	var others []string
+	for _, c := range s.State.Contestants {
+		if c.Name != name {
+			others = append(others, c.Name)
+		}
+	}
+	if len(others) == 0 {
+		return ""
+	}
+	return others[s.Rand.IntN(len(others))]
+}
+
+// showProfile shows the player's character and how their stats have
+// changed over the season.
+func (s *Season) showProfile(title string) error {
+	state := s.State
+	p := state.PlayerCharacter
+	var b strings.Builder
+	b.WriteString(highlightPlayer(p.Name) + ": " + p.Personality + ", " + p.EyeColor + "-eyed, " + p.HairColor + "-haired, " + p.Height + " " + p.Noun + ".\n")
+	if p.Hometown != "" {
+		b.WriteString("From " + p.Hometown + ".\n")
+	}
+	b.WriteString("\n")
+	for _, st := range Stats {
+		v := statValue(p, st)
+		b.WriteString(padRight(capitalize(st), 16) + strings.Repeat("★", v) + strings.Repeat("☆", max(statCap-v, 0)) + "\n")
+	}
+	b.WriteString("\n" + meters(p) + "\n")
+	if h := state.StatHistory[p.Name]; len(h) > 0 {
+		b.WriteString("\n" + padRight("", 28) + "Cha  Att  Str\n")
+		for _, line := range h {
+			b.WriteString(padRight(line.Label, 28) + padRight(strconv.Itoa(line.Charisma), 5) + padRight(strconv.Itoa(line.Attractiveness), 5) + strconv.Itoa(line.Strength) + "\n")
+		}
+	}
+	return s.show(Event{Title: title + ": Your Profile", Text: strings.TrimRight(b.String(), "\n")})
+}
+
+func padRight(s string, n int) string {
+	if len(s) >= n {
+		return s + " "
+	}
+	return s + strings.Repeat(" ", n-len(s))
+}
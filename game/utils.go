@@ -13,3 +13,28 @@ var titleStyle = lipgloss.NewStyle().
 func PrintTitle(title string) {
     fmt.Println(titleStyle.Render(title))
 }
+
+func highlightBachelor(name string) string {
+    return "\033[1;92m" + name + "\033[0m"
+}
+
+func highlightPlayer(name string) string {
+    return "\033[1;36m" + name + "\033[0m"
+}
+
+func highlightContestant(name string) string {
+    return "\033[95m" + name + "\033[0m"
+}
+
+func highlightEliminated(name string) string {
+    return "\033[91m" + name + "\033[0m"
+}
+
+// nameList joins highlighted contestant names into a readable list.
+func nameList(plain []string) string {
+    names := make([]string, len(plain))
+    for i, n := range plain {
+        names[i] = highlightContestant(n)
+    }
+    return joinList(names)
+}
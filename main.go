@@ -1,23 +1,84 @@
 package main
 
 import (
+    "errors"
+    "flag"
+    "fmt"
+    "os"
+    "strings"
+
+    "github.com/charmbracelet/huh"
     "github.com/yourusername/bachelor-sim/game"
+    "github.com/yourusername/bachelor-sim/quick"
+    "github.com/yourusername/bachelor-sim/sim"
+    "github.com/yourusername/bachelor-sim/ui"
 )
 
+const usage = `usage: bachelor-sim [command] [flags]
+
+commands:
+  classic   the full 25-contestant season (default)
+  quick     the five-contestant weekly season
+  simulate  play seasons headlessly and report game balance
+
+Run "bachelor-sim <command> -h" for the flags of a command.
+`
+
 func main() {
-    state := game.NewGameState()
+    cmd, args := "classic", os.Args[1:]
+    if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+        cmd, args = args[0], args[1:]
+    }
 
-    game.RunIntroduction(&state)
-    game.CreatePlayerCharacter(&state)
+    var err error
+    switch cmd {
+    case "classic":
+        err = runClassic(args)
+    case "quick":
+        err = quick.Command(args)
+    case "simulate":
+        err = sim.Command(args, os.Stdout)
+    case "help":
+        fmt.Print(usage)
+        return
+    default:
+        fmt.Fprintf(os.Stderr, "unknown command %q\n\n%s", cmd, usage)
+        os.Exit(2)
+    }
 
-    game.GenerateContestants(&state)
-    game.IntroduceContestants(&state)
-    game.IntroduceBachelor(&state)
+    if errors.Is(err, flag.ErrHelp) {
+        return
+    }
+    if errors.Is(err, huh.ErrUserAborted) {
+        fmt.Println("Cancelled.")
+        return
+    }
+    if err != nil {
+        fmt.Fprintf(os.Stderr, "%s: %v\n", cmd, err)
+        os.Exit(1)
+    }
+}
+
+func runClassic(args []string) error {
+    fs := flag.NewFlagSet("classic", flag.ContinueOnError)
+    seed := fs.Uint64("seed", 0, "replay a season from its seed (0 picks a random one)")
+    var packs game.PackList
+    fs.Var(&packs, "pack", "load an extra content pack (YAML or JSON); repeatable")
+    if err := fs.Parse(args); err != nil {
+        return err
+    }
+    if err := game.LoadContent(packs...); err != nil {
+        return err
+    }
+    if *seed == 0 {
+        *seed = game.NewSeed()
+    }
+
+    state := game.NewGameState()
 
-    game.RunFirstImpression(&state)
-    game.RunSession1(&state)
-    game.RunSession2(&state)
-    game.RunSession3(&state)
-    game.RunFantasySuites(&state)
-    game.RunProposal(&state)
+    season := game.NewSeason(&state, ui.NewHuh(), *seed)
+    if path, err := game.DefaultSavePath(); err == nil {
+        season.SavePath = path
+    }
+    return season.Run()
 }
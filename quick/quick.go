@@ -1,16 +1,21 @@
-package main
+// Package quick is the five-contestant Bubble Tea season: one week at a
+// time, a one-on-one, a group date, some drama and a rose ceremony. The
+// one-on-one is one of the content packs' date dialogues, played against the
+// quick season's scores.
+package quick
 
 import (
+    "flag"
     "fmt"
-    "math/rand"
-    "os"
+    "math"
+    "math/rand/v2"
     "sort"
     "strings"
-    "time"
 
     "github.com/charmbracelet/bubbles/textinput"
     tea "github.com/charmbracelet/bubbletea"
     "github.com/charmbracelet/lipgloss"
+    "github.com/yourusername/bachelor-sim/game"
 )
 
 // ---------- Data Models ---------
@@ -28,42 +33,16 @@ const (
     StateWin
 )
 
-// Contestant represents a contestant in the show (player or AI).
-
-type Contestant struct {
-    Name          string
-    Charisma      int
-    Attractiveness int
-    Intelligence  int
-    EyeColor      string
-    HairColor     string
-    Height        string
-    Personality   string
-    Score         float64
-    IsPlayer      bool
-}
+// Tastes are the Bachelor's hidden weights for each stat.
 
-type Bachelor struct {
-    Name  string
-    PrefC float64
-    PrefA float64
-    PrefI float64
+type Tastes struct {
+    Charisma       float64
+    Attractiveness float64
+    Strength       float64
     // simple preference: dislikes drama if true
     DislikesDrama bool
 }
 
-// Choice for one‑on‑one scenario
-
-type Choice struct {
-    Text string
-    Stat string // "charisma", "attractiveness", "intelligence"
-}
-
-type Scenario struct {
-    Description string
-    Choices     []Choice
-}
-
 // Model for Bubble Tea
 
 type Model struct {
@@ -71,18 +50,30 @@ type Model struct {
 
     week int
 
+    // every random roll comes from rng so a seed replays the run
+    seed uint64
+    rng  *rand.Rand
+
     // text input for customization
     ti           textinput.Model
     questionStep int
 
-    player        Contestant
-    bachelor      Bachelor
-    contestants   []Contestant // includes player & AI (current).
+    player        game.Character
+    bachelor      game.Character
+    leadTitle     string
+    leadPronouns  game.Pronouns
+    tastes        Tastes
+    contestants   []game.Character // includes player & AI (current).
+    scores        map[string]float64
 
     // one‑on‑one UI
-    currentScenario Scenario
-    cursor          int // menu selection index
-    outcomeText     string
+    date        *game.Conversation
+    flags       map[string]bool // set by the date dialogues
+    cursor      int // menu selection index
+    outcomeText string
+
+    // err is why the season had to stop early
+    err error
 
     // drama flag
     dramaOccurred bool
@@ -94,77 +85,65 @@ type Model struct {
 
 // ---------- Utility Functions ---------
 
-func randName() string {
-    names := []string{"Alex", "Jordan", "Taylor", "Sam", "Casey", "Jamie", "Morgan", "Riley", "Dana", "Skyler", "Cameron", "Quinn", "Sydney", "Avery", "Peyton", "Harper"}
-    return names[rand.Intn(len(names))]
-}
-
-func randEye() string {
-    eyes := []string{"blue", "green", "brown", "hazel", "gray"}
-    return eyes[rand.Intn(len(eyes))]
-}
-
-func randHair() string {
-    hair := []string{"blonde", "brunette", "black", "red", "auburn"}
-    return hair[rand.Intn(len(hair))]
-}
-
-func randHeight() string {
-    heights := []string{"5'2\"", "5'4\"", "5'6\"", "5'8\"", "5'10\"", "6'0\""}
-    return heights[rand.Intn(len(heights))]
-}
-
-func randPersonality() string {
-    pers := []string{"witty", "shy", "outgoing", "competitive", "thoughtful", "adventurous"}
-    return pers[rand.Intn(len(pers))]
+func generateTastes(rng *rand.Rand) Tastes {
+    // random weights that sum to 1
+    a, b, c := rng.Float64(), rng.Float64(), rng.Float64()
+    sum := a + b + c
+    return Tastes{
+        Charisma:       a / sum,
+        Attractiveness: b / sum,
+        Strength:       c / sum,
+        DislikesDrama:  rng.IntN(2) == 0,
+    }
 }
 
-func generateContestant(isPlayer bool) Contestant {
-    c := Contestant{
-        Name:           randName(),
-        Charisma:       rand.Intn(6) + 5,        // 5‑10
-        Attractiveness: rand.Intn(6) + 5,
-        Intelligence:   rand.Intn(6) + 5,
-        EyeColor:       randEye(),
-        HairColor:      randHair(),
-        Height:         randHeight(),
-        Personality:    randPersonality(),
-        Score:          0,
-        IsPlayer:       isPlayer,
+// statValue looks up one of a character's stats by name
+func statValue(c game.Character, stat string) int {
+    switch stat {
+    case "charisma":
+        return c.Charisma
+    case "attractiveness":
+        return c.Attractiveness
+    case "strength":
+        return c.Strength
     }
-    return c
+    return 0
 }
 
-func generateBachelor() Bachelor {
-    // random weights that sum to 1
-    a, b, c := rand.Float64(), rand.Float64(), rand.Float64()
-    sum := a + b + c
-    return Bachelor{
-        Name:          randName(),
-        PrefC:         a / sum,
-        PrefA:         b / sum,
-        PrefI:         c / sum,
-        DislikesDrama: rand.Intn(2) == 0,
+// weight is how much the Bachelor cares about a stat
+func (t Tastes) weight(stat string) float64 {
+    switch stat {
+    case "charisma":
+        return t.Charisma
+    case "attractiveness":
+        return t.Attractiveness
+    case "strength":
+        return t.Strength
     }
+    return 0
 }
 
 // base compatibility calculation
-func baseScore(b Bachelor, c Contestant) float64 {
-    return float64(c.Charisma)*b.PrefC + float64(c.Attractiveness)*b.PrefA + float64(c.Intelligence)*b.PrefI
+func baseScore(t Tastes, c game.Character) float64 {
+    return float64(c.Charisma)*t.Charisma + float64(c.Attractiveness)*t.Attractiveness + float64(c.Strength)*t.Strength
 }
 
 // create initial model
-func initialModel() Model {
-    rand.Seed(time.Now().UnixNano())
-
+func initialModel(seed uint64) Model {
     m := Model{
         state:        StateCustomize,
         week:         1,
         questionStep: 0,
+        seed:         seed,
+        rng:          game.NewRand(seed),
+        leadTitle:    "Bachelor",
+        leadPronouns: game.HeHim,
     }
 
     // generate default player (will customize)
-    m.player = generateContestant(true)
+    m.player = game.GenerateRandomContestants(m.rng, 1, &game.GameState{})[0]
+    m.player.IsPlayer = true
+    m.player.Noun = "player"
 
     // text input init
     ti := textinput.New()
@@ -181,70 +160,103 @@ func initialModel() Model {
 // prepare game after customization complete
 func (m *Model) startGame() {
     // generate bachelor and AI contestants
-    m.bachelor = generateBachelor()
-
-    totalContestants := 5 // player + 4 AI
-    m.contestants = []Contestant{m.player}
-    for len(m.contestants) < totalContestants {
-        c := generateContestant(false)
-        // ensure unique names to avoid confusion
-        duplicate := false
-        for _, exist := range m.contestants {
-            if exist.Name == c.Name {
-                duplicate = true
-                break
-            }
-        }
-        if !duplicate {
-            c.Score = baseScore(m.bachelor, c)
-            m.contestants = append(m.contestants, c)
-        }
-    }
+    m.bachelor = game.GenerateBachelor(m.rng, m.leadTitle, m.leadPronouns)
+    m.tastes = generateTastes(m.rng)
 
-    // compute player's initial score
-    for i := range m.contestants {
-        if m.contestants[i].IsPlayer {
-            m.contestants[i].Score = baseScore(m.bachelor, m.contestants[i])
-            m.player = m.contestants[i]
-            break
-        }
+    // player + 4 AI, with names unique against the player's
+    state := game.GameState{PlayerCharacter: m.player}
+    m.contestants = append([]game.Character{m.player}, game.GenerateRandomContestants(m.rng, 4, &state)...)
+
+    m.scores = make(map[string]float64)
+    for _, c := range m.contestants {
+        m.scores[c.Name] = baseScore(m.tastes, c)
     }
 
-    // pick first scenario
-    m.currentScenario = randomScenario()
+    // first date
+    m.flags = make(map[string]bool)
+    m.startDate()
+}
+
+// narrate fills in the lead's title and pronouns
+func (m Model) narrate(text string) string {
+    return game.Narrate(text, m.leadTitle, m.leadPronouns)
+}
+
+// startDate sends the player on a random one‑on‑one from the loaded
+// content packs
+func (m *Model) startDate() {
+    dates := game.CurrentContent().Dates()
+    host := dateHost{
+        player:   m.player,
+        bachelor: m.bachelor,
+        tastes:   m.tastes,
+        scores:   m.scores,
+        flags:    m.flags,
+        rng:      m.rng,
+    }
+    m.date, m.err = game.NewConversation(dates[m.rng.IntN(len(dates))].ID, "", host)
     m.cursor = 0
+    m.outcomeText = ""
     m.state = StateOneOnOne
 }
 
-// random one‑on‑one scenario selection
-func randomScenario() Scenario {
-    scenarios := []Scenario{
-        {
-            Description: "You and the Bachelor enjoy a cozy dinner. He asks about your passions.",
-            Choices: []Choice{
-                {"Open up sincerely about your career ambitions", "intelligence"},
-                {"Flirt playfully about your future together", "charisma"},
-                {"Ask him about his own goals instead", "charisma"},
-            },
-        },
-        {
-            Description: "You go hiking with the Bachelor and stop at a scenic viewpoint.",
-            Choices: []Choice{
-                {"Share an adventurous travel story", "charisma"},
-                {"Compliment the view and his company", "attractiveness"},
-                {"Discuss environmental conservation efforts", "intelligence"},
-            },
-        },
-        {
-            Description: "You both attend a private cooking class together.",
-            Choices: []Choice{
-                {"Take charge and show your cooking skills", "intelligence"},
-                {"Joke around and taste‑test ingredients playfully", "charisma"},
-                {"Present the Bachelor with a beautifully plated dish", "attractiveness"},
-            },
-        },
+// dateHost plays a date dialogue against the quick season's scores. The
+// Bachelor goes easier on checks of a stat they care about.
+type dateHost struct {
+    player   game.Character
+    bachelor game.Character
+    tastes   Tastes
+    scores   map[string]float64
+    flags    map[string]bool
+    rng      *rand.Rand
+}
+
+func (h dateHost) Value(name string) int {
+    if name == "relationship" {
+        return int(h.scores[h.player.Name])
+    }
+    // the quick season keeps no mood, energy or stress
+    return statValue(h.player, name)
+}
+
+func (h dateHost) Flag(name string) bool {
+    return h.flags[name]
+}
+
+func (h dateHost) Check(c game.Check) bool {
+    stat := statValue(h.player, c.Stat)
+    switch c.Who {
+    case "lead":
+        stat = statValue(h.bachelor, c.Stat)
+    case "both":
+        stat += statValue(h.bachelor, c.Stat) / 2
+    }
+    bonus := int(math.Round(6 * h.tastes.weight(c.Stat)))
+    return h.rng.IntN(20)+stat+bonus > c.Difficulty
+}
+
+// Apply moves the player's score; there are no dealbreakers to trip
+func (h dateHost) Apply(e game.Effects) string {
+    h.scores[h.player.Name] += float64(e.Relationship)
+    if e.Set != "" {
+        h.flags[e.Set] = true
+    }
+    delete(h.flags, e.Clear)
+    return ""
+}
+
+func (h dateHost) Var(name string) string {
+    switch name {
+    case "player":
+        return h.player.Name
+    case "lead":
+        return h.bachelor.Name
+    case "hometown":
+        return h.player.Hometown
+    case "family":
+        return "your family"
     }
-    return scenarios[rand.Intn(len(scenarios))]
+    return ""
 }
 
 // ---------- Bubble Tea Update & View ---------
@@ -255,6 +267,9 @@ func (m Model) Init() tea.Cmd {
 
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+    if m.err != nil {
+        return m, tea.Quit
+    }
     switch m.state {
     case StateCustomize:
         return updateCustomize(m, msg)
@@ -301,22 +316,43 @@ func updateCustomize(m Model, msg tea.Msg) (tea.Model, tea.Cmd) {
                 }
                 m.questionStep++
                 m.ti.SetValue("")
-                m.ti.Placeholder = "Eye color (press Enter to keep random)"
+                m.ti.Placeholder = "Your pronouns: he, she or they (press Enter to keep she)"
             case 1:
+                if input != "" && input != m.ti.Placeholder {
+                    m.player.Pronouns = game.ParsePronouns(input)
+                }
+                m.questionStep++
+                m.ti.SetValue("")
+                m.ti.Placeholder = "Bachelor or Bachelorette (press Enter to keep Bachelor)"
+            case 2:
+                if input != "" && input != m.ti.Placeholder {
+                    m.leadTitle = game.ParseLeadTitle(input)
+                }
+                m.questionStep++
+                m.ti.SetValue("")
+                m.ti.Placeholder = "The lead's pronouns: he, she or they (press Enter to keep he)"
+            case 3:
+                if input != "" && input != m.ti.Placeholder {
+                    m.leadPronouns = game.ParsePronouns(input)
+                }
+                m.questionStep++
+                m.ti.SetValue("")
+                m.ti.Placeholder = "Eye color (press Enter to keep random)"
+            case 4:
                 if input != "" {
                     m.player.EyeColor = input
                 }
                 m.questionStep++
                 m.ti.SetValue("")
                 m.ti.Placeholder = "Hair color (press Enter to keep random)"
-            case 2:
+            case 5:
                 if input != "" {
                     m.player.HairColor = input
                 }
                 m.questionStep++
                 m.ti.SetValue("")
                 m.ti.Placeholder = "Height (press Enter to keep random)"
-            case 3:
+            case 6:
                 if input != "" {
                     m.player.Height = input
                 }
@@ -340,64 +376,45 @@ func updateOneOnOne(m Model, msg tea.Msg) (tea.Model, tea.Cmd) {
                 m.cursor--
             }
         case "down", "j":
-            if m.cursor < len(m.currentScenario.Choices)-1 {
+            if m.cursor < len(m.date.Choices())-1 {
                 m.cursor++
             }
         case "enter":
-            // resolve choice
-            choice := m.currentScenario.Choices[m.cursor]
-            delta := resolveChoice(&m, choice)
-            m.outcomeText = fmt.Sprintf("You chose: %s ( %+0.1f points )", choice.Text, delta)
-            m.cursor = 0
-            // proceed to group date after showing outcome (require another Enter)
-            m.state = StateGroupDate
+            if m.date.Done() {
+                m.startGroupDate()
+                return m, nil
+            }
+            // answer or carry on, showing the reply and what it was worth
+            before := m.scores[m.player.Name]
+            if len(m.date.Choices()) == 0 {
+                m.outcomeText = ""
+                m.err = m.date.Continue()
+            } else {
+                m.outcomeText, m.err = m.date.Choose(m.cursor)
+                m.cursor = 0
+            }
+            if delta := m.scores[m.player.Name] - before; delta != 0 {
+                m.outcomeText = strings.TrimSpace(fmt.Sprintf("%s (%+0.1f points)", m.outcomeText, delta))
+            }
+            if m.date.Done() && m.outcomeText == "" {
+                m.startGroupDate()
+            }
         }
     }
     return m, nil
 }
 
-// compute delta and update player score
-func resolveChoice(m *Model, ch Choice) float64 {
-    statVal := 0
-    switch ch.Stat {
-    case "charisma":
-        statVal = m.player.Charisma
-    case "attractiveness":
-        statVal = m.player.Attractiveness
-    case "intelligence":
-        statVal = m.player.Intelligence
-    }
-    var weight float64
-    switch ch.Stat {
-    case "charisma":
-        weight = m.bachelor.PrefC
-    case "attractiveness":
-        weight = m.bachelor.PrefA
-    case "intelligence":
-        weight = m.bachelor.PrefI
-    }
-    // simple: delta = weight * statVal / 2 + random noise [‑1,1]
-    delta := weight*float64(statVal)/2 + (rand.Float64()*2 - 1)
-    // update player's score in contestants slice
-    for i := range m.contestants {
-        if m.contestants[i].IsPlayer {
-            m.contestants[i].Score += delta
-            m.player = m.contestants[i]
-            break
-        }
-    }
-    return delta
+// startGroupDate simulates the week's group date once; afterwards the
+// group date waits for Enter to continue
+func (m *Model) startGroupDate() {
+    // pick random event stat
+    stats := []string{"charisma", "attractiveness", "strength"}
+    m.groupEventStat = stats[m.rng.IntN(len(stats))]
+    simulateGroupDate(m)
+    m.state = StateGroupDate
 }
 
 func updateGroupDate(m Model, msg tea.Msg) (tea.Model, tea.Cmd) {
-    // On first entry to this state, simulate group date once; afterwards wait for Enter to continue
-    if m.groupEventStat == "" {
-        // pick random event stat
-        stats := []string{"charisma", "attractiveness", "intelligence"}
-        m.groupEventStat = stats[rand.Intn(len(stats))]
-        simulateGroupDate(&m)
-        return m, nil
-    }
 
     if key, ok := msg.(tea.KeyMsg); ok {
         if key.String() == "enter" {
@@ -416,16 +433,8 @@ func simulateGroupDate(m *Model) {
     bestIdx := 0
     bestPerf := -1
     for i := range m.contestants {
-        var stat int
-        switch m.groupEventStat {
-        case "charisma":
-            stat = m.contestants[i].Charisma
-        case "attractiveness":
-            stat = m.contestants[i].Attractiveness
-        case "intelligence":
-            stat = m.contestants[i].Intelligence
-        }
-        perf := stat + rand.Intn(4)
+        stat := statValue(m.contestants[i], m.groupEventStat)
+        perf := stat + m.rng.IntN(4)
         if perf > bestPerf {
             bestPerf = perf
             bestIdx = i
@@ -439,12 +448,9 @@ func simulateGroupDate(m *Model) {
         if i == bestIdx {
             gain = 6.0
         }
-        m.contestants[i].Score += gain * rand.Float64() // small randomization
-        if m.contestants[i].IsPlayer {
-            m.player = m.contestants[i]
-        }
+        m.scores[m.contestants[i].Name] += gain * m.rng.Float64() // small randomization
     }
-    m.outcomeText = fmt.Sprintf("Group date focused on %s. %s impressed the Bachelor!", m.groupEventStat, m.contestants[bestIdx].Name)
+    m.outcomeText = fmt.Sprintf("Group date focused on %s. %s impressed the {Bachelor}!", m.groupEventStat, m.contestants[bestIdx].Name)
 }
 
 func updateDrama(m Model, msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -467,38 +473,26 @@ func updateDrama(m Model, msg tea.Msg) (tea.Model, tea.Cmd) {
 func handleDrama(m *Model) {
     m.dramaOccurred = true
     // 50% chance event involves player
-    playerInvolved := rand.Intn(2) == 0
+    playerInvolved := m.rng.IntN(2) == 0
     if playerInvolved {
         // simple: player argued; if bachelor dislikes drama -> penalty else minor gain
-        if m.bachelor.DislikesDrama {
-            delta := -3.0
-            for i := range m.contestants {
-                if m.contestants[i].IsPlayer {
-                    m.contestants[i].Score += delta
-                    m.player = m.contestants[i]
-                }
-            }
-            m.outcomeText = "You got into a brief argument during the cocktail party. The Bachelor dislikes drama and seems disappointed."
+        if m.tastes.DislikesDrama {
+            m.scores[m.player.Name] -= 3
+            m.outcomeText = "You got into a brief argument during the cocktail party. The {Bachelor} dislikes drama and seems disappointed."
         } else {
-            delta := 2.0
-            for i := range m.contestants {
-                if m.contestants[i].IsPlayer {
-                    m.contestants[i].Score += delta
-                    m.player = m.contestants[i]
-                }
-            }
-            m.outcomeText = "Your fiery spirit caught the Bachelor’s eye! He seems intrigued by the drama."
+            m.scores[m.player.Name] += 2
+            m.outcomeText = "Your fiery spirit caught the {Bachelor}’s eye! {He} seem{s} intrigued by the drama."
         }
     } else {
         // two AI contestants drama; they each lose small
-        idx1 := rand.Intn(len(m.contestants))
-        idx2 := rand.Intn(len(m.contestants))
+        idx1 := m.rng.IntN(len(m.contestants))
+        idx2 := m.rng.IntN(len(m.contestants))
         for idx2 == idx1 || m.contestants[idx2].IsPlayer {
-            idx2 = rand.Intn(len(m.contestants))
+            idx2 = m.rng.IntN(len(m.contestants))
         }
-        m.contestants[idx1].Score -= 2
-        m.contestants[idx2].Score -= 2
-        m.outcomeText = fmt.Sprintf("%s and %s had a heated argument, turning the Bachelor off.", m.contestants[idx1].Name, m.contestants[idx2].Name)
+        m.scores[m.contestants[idx1].Name] -= 2
+        m.scores[m.contestants[idx2].Name] -= 2
+        m.outcomeText = fmt.Sprintf("%s and %s had a heated argument, turning the {Bachelor} off.", m.contestants[idx1].Name, m.contestants[idx2].Name)
     }
 }
 
@@ -507,7 +501,7 @@ func updateCeremony(m Model, msg tea.Msg) (tea.Model, tea.Cmd) {
         if key.String() == "enter" {
             // eliminate lowest and proceed
             sort.Slice(m.contestants, func(i, j int) bool {
-                return m.contestants[i].Score > m.contestants[j].Score
+                return m.scores[m.contestants[i].Name] > m.scores[m.contestants[j].Name]
             })
             eliminated := m.contestants[len(m.contestants)-1]
             if eliminated.IsPlayer {
@@ -524,8 +518,7 @@ func updateCeremony(m Model, msg tea.Msg) (tea.Model, tea.Cmd) {
                 m.contestants = m.contestants[:len(m.contestants)-1]
                 m.week++
                 // prepare next week
-                m.currentScenario = randomScenario()
-                m.state = StateOneOnOne
+                m.startDate()
             }
         } else if key.String() == "q" || key.Type == tea.KeyCtrlC {
             return m, tea.Quit
@@ -541,11 +534,14 @@ var (
     playerStyle     = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("205"))
     eliminatedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("9"))
     highlightStyle  = lipgloss.NewStyle().Foreground(lipgloss.Color("10")).Bold(true)
-    wrapStyle       = lipgloss.NewStyle().MaxWidth(80)
+    wrapStyle       = lipgloss.NewStyle().Width(80)
 )
 
 func (m Model) View() string {
     var b strings.Builder
+    if m.err != nil {
+        return "Something went wrong: " + m.err.Error() + "\n"
+    }
 
     switch m.state {
     case StateCustomize:
@@ -555,10 +551,22 @@ func (m Model) View() string {
 
     case StateOneOnOne:
         b.WriteString(titleStyle.Render(fmt.Sprintf("Week %d – One‑on‑One Date\n\n", m.week)))
-        b.WriteString(wrapStyle.Render(m.currentScenario.Description) + "\n\n")
-        for i, ch := range m.currentScenario.Choices {
+        if m.outcomeText != "" {
+            b.WriteString(wrapStyle.Render(m.narrate(m.outcomeText)) + "\n\n")
+        }
+        var choices []string
+        if !m.date.Done() {
+            b.WriteString(wrapStyle.Render(m.narrate(game.SpokenText(m.date.Speaker(), m.date.Text()))) + "\n\n")
+            choices = m.date.Choices()
+        }
+        if len(choices) == 0 {
+            b.WriteString("Press Enter to continue. q to quit.\n")
+            break
+        }
+        b.WriteString(m.narrate(m.date.Ask()) + "\n\n")
+        for i, ch := range choices {
             cursor := "  "
-            text := ch.Text
+            text := m.narrate(ch)
             if i == m.cursor {
                 cursor = "> "
                 text = highlightStyle.Render(text)
@@ -569,22 +577,22 @@ func (m Model) View() string {
 
     case StateGroupDate:
         b.WriteString(titleStyle.Render(fmt.Sprintf("Week %d – Group Date\n\n", m.week)))
-        b.WriteString(m.outcomeText + "\n\n")
+        b.WriteString(m.narrate(m.outcomeText) + "\n\n")
         b.WriteString("Press Enter to continue.\n")
 
     case StateDrama:
         b.WriteString(titleStyle.Render(fmt.Sprintf("Week %d – Mansion Dynamics\n\n", m.week)))
-        b.WriteString(m.outcomeText + "\n\n")
+        b.WriteString(m.narrate(m.outcomeText) + "\n\n")
         b.WriteString("Press Enter to proceed to the Rose Ceremony.\n")
 
     case StateCeremony:
         b.WriteString(titleStyle.Render(fmt.Sprintf("Week %d – Rose Ceremony\n\n", m.week)))
         // sort a copy for display
-        copyList := make([]Contestant, len(m.contestants))
+        copyList := make([]game.Character, len(m.contestants))
         copy(copyList, m.contestants)
-        sort.Slice(copyList, func(i, j int) bool { return copyList[i].Score > copyList[j].Score })
+        sort.Slice(copyList, func(i, j int) bool { return m.scores[copyList[i].Name] > m.scores[copyList[j].Name] })
         for i, c := range copyList {
-            line := fmt.Sprintf("%d. %s – %.1f", i+1, c.Name, c.Score)
+            line := fmt.Sprintf("%d. %s – %.1f", i+1, c.Name, m.scores[c.Name])
             if c.IsPlayer {
                 line = playerStyle.Render(line)
             }
@@ -600,10 +608,12 @@ func (m Model) View() string {
     case StateGameOver:
         b.WriteString(eliminatedStyle.Render("You have been eliminated. 😢\n"))
         b.WriteString("Thank you for playing! Press q to quit.\n")
+        b.WriteString(fmt.Sprintf("\nSeed: %d (replay with --seed %d)\n", m.seed, m.seed))
 
     case StateWin:
         b.WriteString(playerStyle.Render("🎉 You received the final rose! You win! 🎉\n"))
         b.WriteString("Congratulations on finding love in the terminal. Press q to quit.\n")
+        b.WriteString(fmt.Sprintf("\nSeed: %d (replay with --seed %d)\n", m.seed, m.seed))
     default:
         b.WriteString("Unknown state")
     }
@@ -611,12 +621,27 @@ func (m Model) View() string {
     return b.String()
 }
 
-// ---------- main ----------
+// ---------- command ----------
+
+// Command implements `bachelor-sim quick`.
+func Command(args []string) error {
+    fs := flag.NewFlagSet("quick", flag.ContinueOnError)
+    seed := fs.Uint64("seed", 0, "replay a run from its seed (0 picks a random one)")
+    var packs game.PackList
+    fs.Var(&packs, "pack", "load an extra content pack (YAML or JSON); repeatable")
+    if err := fs.Parse(args); err != nil {
+        return err
+    }
+    if err := game.LoadContent(packs...); err != nil {
+        return err
+    }
+    if *seed == 0 {
+        *seed = game.NewSeed()
+    }
 
-func main() {
-    p := tea.NewProgram(initialModel())
-    if err := p.Start(); err != nil {
-        fmt.Fprintf(os.Stderr, "Error running program: %v\n", err)
-        os.Exit(1)
+    final, err := tea.NewProgram(initialModel(*seed)).Run()
+    if err != nil {
+        return err
     }
+    return final.(Model).err
 }
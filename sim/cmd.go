@@ -0,0 +1,72 @@
+package sim
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/yourusername/bachelor-sim/game"
+)
+
+// Command implements `bachelor-sim simulate`.
+func Command(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("simulate", flag.ContinueOnError)
+	n := fs.Int("n", 10000, "number of seasons to play")
+	builds := fs.String("builds", "all", "comma-separated charisma/attractiveness/strength builds, or \"all\" for every 9-point build")
+	policy := fs.String("policy", string(PolicyRandom), "how the player answers prompts: random or first")
+	seed := fs.Uint64("seed", 1, "seed of the first season; season i uses seed+i")
+	workers := fs.Int("workers", 0, "parallel workers (0 uses every CPU)")
+	var packs game.PackList
+	fs.Var(&packs, "pack", "load an extra content pack (YAML or JSON); repeatable")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if err := game.LoadContent(packs...); err != nil {
+		return err
+	}
+
+	cfg := Config{Seasons: *n, Policy: Policy(*policy), Seed: *seed, Workers: *workers}
+	switch cfg.Policy {
+	case PolicyRandom, PolicyFirst:
+	default:
+		return fmt.Errorf("unknown policy %q", *policy)
+	}
+	if *builds == "all" {
+		cfg.Builds = AllBuilds(9)
+	} else {
+		for _, s := range strings.Split(*builds, ",") {
+			b, err := ParseBuild(s)
+			if err != nil {
+				return err
+			}
+			cfg.Builds = append(cfg.Builds, b)
+		}
+	}
+
+	results, err := Run(cfg)
+	if err != nil {
+		return err
+	}
+	WriteReport(out, Summarize(results))
+	return nil
+}
+
+// WriteReport prints a summary as a table.
+func WriteReport(out io.Writer, s Summary) {
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "build (C/A/S)\tseasons\twin rate\tfinal rose\tavg week out\t")
+	for _, b := range s.Builds {
+		fmt.Fprintf(tw, "%s\t%d\t%.1f%%\t%.1f%%\t%.2f\t\n",
+			b.Build, b.Seasons, 100*b.WinRate(), 100*float64(b.Finalists)/float64(b.Seasons), b.AvgWeek)
+	}
+	tw.Flush()
+
+	fmt.Fprintln(out)
+	fmt.Fprintln(out, "correlation with winning:")
+	for _, name := range []string{"charisma", "attractiveness", "strength"} {
+		fmt.Fprintf(out, "  %-15s %+.3f\n", name, s.Correlation[name])
+	}
+	fmt.Fprintf(out, "strongest stat: %s\n", s.StrongestStat())
+}
@@ -0,0 +1,294 @@
+// Package sim plays whole seasons headlessly to measure game balance.
+package sim
+
+import (
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/yourusername/bachelor-sim/game"
+)
+
+// Build is a player stat allocation.
+type Build struct {
+	Charisma       int
+	Attractiveness int
+	Strength       int
+}
+
+func (b Build) String() string {
+	return fmt.Sprintf("%d/%d/%d", b.Charisma, b.Attractiveness, b.Strength)
+}
+
+// ParseBuild reads a build written as charisma/attractiveness/strength.
+func ParseBuild(s string) (Build, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 3 {
+		return Build{}, fmt.Errorf("build %q: want charisma/attractiveness/strength", s)
+	}
+	var stats [3]int
+	for i, p := range parts {
+		n, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil || n < 1 || n > 5 {
+			return Build{}, fmt.Errorf("build %q: stats must be 1-5", s)
+		}
+		stats[i] = n
+	}
+	return Build{stats[0], stats[1], stats[2]}, nil
+}
+
+// AllBuilds lists every legal build that spends the whole stat budget.
+func AllBuilds(budget int) []Build {
+	var builds []Build
+	for c := 1; c <= 5; c++ {
+		for a := 1; a <= 5; a++ {
+			st := budget - c - a
+			if st >= 1 && st <= 5 {
+				builds = append(builds, Build{c, a, st})
+			}
+		}
+	}
+	return builds
+}
+
+// Policy decides how the simulated player answers prompts.
+type Policy string
+
+const (
+	PolicyRandom Policy = "random"
+	PolicyFirst  Policy = "first"
+)
+
+// bot is a Frontend that plays a build with a policy and never renders.
+type bot struct {
+	build  Build
+	policy Policy
+	rng    *rand.Rand
+}
+
+func (b *bot) Show(ev game.Event) error {
+	return nil
+}
+
+func (b *bot) Ask(p game.Prompt) (game.Answers, error) {
+	a := game.Answers{}
+	for _, f := range p.Fields {
+		switch f.Key {
+		case "name":
+			a[f.Key] = "Player"
+		case "charisma":
+			a[f.Key] = strconv.Itoa(b.build.Charisma)
+		case "attractiveness":
+			a[f.Key] = strconv.Itoa(b.build.Attractiveness)
+		case "strength":
+			a[f.Key] = strconv.Itoa(b.build.Strength)
+		default:
+			// a simulated player never walks off the show, nor away from
+			// the proposal
+			var stay []game.Option
+			for _, o := range f.Options {
+				if o.Value != "leave" && o.Value != "walk" {
+					stay = append(stay, o)
+				}
+			}
+			a[f.Key] = b.pick(game.Field{Options: stay})
+		}
+	}
+	return a, nil
+}
+
+func (b *bot) pick(f game.Field) string {
+	if len(f.Options) == 0 {
+		return "I love you and I can see our future together."
+	}
+	if b.policy == PolicyFirst {
+		return f.Options[0].Value
+	}
+	return f.Options[b.rng.IntN(len(f.Options))].Value
+}
+
+// Result is the outcome of one simulated season.
+type Result struct {
+	Build Build
+	Won   bool
+	// Ending is how the season finished for the player.
+	Ending game.Ending
+	// Week is the week the player was sent home, or 0 if they never were.
+	Week int
+}
+
+// Play runs one season to the end.
+func Play(build Build, policy Policy, seed uint64) (Result, error) {
+	state := game.NewGameState()
+	b := &bot{build: build, policy: policy, rng: rand.New(rand.NewPCG(seed, ^seed))}
+	if err := game.NewSeason(&state, b, seed).Run(); err != nil {
+		return Result{}, err
+	}
+
+	r := Result{Build: build, Won: state.Ending == game.EndingEngaged, Ending: state.Ending}
+	if state.Ending == game.EndingEliminated {
+		// every ceremony the player stood in adds to their history
+		r.Week = len(state.RelationshipHistory[state.PlayerCharacter.Name])
+	}
+	return r, nil
+}
+
+// Config describes a simulation run.
+type Config struct {
+	Seasons int
+	Builds  []Build
+	Policy  Policy
+	Seed    uint64
+	Workers int
+}
+
+// Run plays cfg.Seasons seasons, cycling through the builds, and returns
+// one result per season in seed order.
+func Run(cfg Config) ([]Result, error) {
+	if len(cfg.Builds) == 0 {
+		return nil, fmt.Errorf("no builds to simulate")
+	}
+	workers := cfg.Workers
+	if workers < 1 {
+		workers = runtime.NumCPU()
+	}
+
+	results := make([]Result, cfg.Seasons)
+	errs := make([]error, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := w; i < cfg.Seasons; i += workers {
+				r, err := Play(cfg.Builds[i%len(cfg.Builds)], cfg.Policy, cfg.Seed+uint64(i))
+				if err != nil {
+					errs[w] = err
+					return
+				}
+				results[i] = r
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return results, nil
+}
+
+// BuildReport summarizes every season played with one build.
+type BuildReport struct {
+	Build   Build
+	Seasons int
+	Wins    int
+	// Finalists is how many seasons the player made it to the proposal.
+	Finalists int
+	// AvgWeek is the average week the player went home among the seasons
+	// where they were eliminated.
+	AvgWeek float64
+}
+
+func (r BuildReport) WinRate() float64 {
+	return float64(r.Wins) / float64(r.Seasons)
+}
+
+// Summary is the aggregate of a simulation run.
+type Summary struct {
+	Builds []BuildReport
+	// Correlation is the Pearson correlation of each stat with winning.
+	Correlation map[string]float64
+}
+
+func Summarize(results []Result) Summary {
+	byBuild := map[Build]*BuildReport{}
+	weeks := map[Build]int{}
+	eliminated := map[Build]int{}
+	var order []Build
+	for _, r := range results {
+		br, ok := byBuild[r.Build]
+		if !ok {
+			br = &BuildReport{Build: r.Build}
+			byBuild[r.Build] = br
+			order = append(order, r.Build)
+		}
+		br.Seasons++
+		if r.Won {
+			br.Wins++
+		}
+		switch r.Ending {
+		case game.EndingEngaged, game.EndingRejected:
+			br.Finalists++
+		case game.EndingEliminated:
+			weeks[r.Build] += r.Week
+			eliminated[r.Build]++
+		}
+	}
+
+	var s Summary
+	for _, b := range order {
+		br := byBuild[b]
+		if eliminated[b] > 0 {
+			br.AvgWeek = float64(weeks[b]) / float64(eliminated[b])
+		}
+		s.Builds = append(s.Builds, *br)
+	}
+
+	won := make([]float64, len(results))
+	stats := map[string][]float64{}
+	for i, r := range results {
+		if r.Won {
+			won[i] = 1
+		}
+		stats["charisma"] = append(stats["charisma"], float64(r.Build.Charisma))
+		stats["attractiveness"] = append(stats["attractiveness"], float64(r.Build.Attractiveness))
+		stats["strength"] = append(stats["strength"], float64(r.Build.Strength))
+	}
+	s.Correlation = map[string]float64{}
+	for name, xs := range stats {
+		s.Correlation[name] = pearson(xs, won)
+	}
+	return s
+}
+
+// StrongestStat is the stat that correlates most with winning.
+func (s Summary) StrongestStat() string {
+	best, bestR := "", math.Inf(-1)
+	for _, name := range []string{"charisma", "attractiveness", "strength"} {
+		if r := s.Correlation[name]; r > bestR {
+			best, bestR = name, r
+		}
+	}
+	return best
+}
+
+func pearson(xs, ys []float64) float64 {
+	n := float64(len(xs))
+	if n == 0 {
+		return 0
+	}
+	var sx, sy float64
+	for i := range xs {
+		sx += xs[i]
+		sy += ys[i]
+	}
+	mx, my := sx/n, sy/n
+	var cov, vx, vy float64
+	for i := range xs {
+		dx, dy := xs[i]-mx, ys[i]-my
+		cov += dx * dy
+		vx += dx * dx
+		vy += dy * dy
+	}
+	if vx == 0 || vy == 0 {
+		return 0
+	}
+	return cov / math.Sqrt(vx*vy)
+}
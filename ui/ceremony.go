@@ -0,0 +1,147 @@
+package ui
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bachelor-sim/game"
+)
+
+var (
+	ceremonyTitleStyle = lipgloss.NewStyle().Bold(true).Underline(true)
+	ceremonyHintStyle  = lipgloss.NewStyle().Faint(true)
+	ceremonyWrapStyle  = lipgloss.NewStyle().Width(80)
+)
+
+// beat is one line of a ceremony and how long to hold on it.
+type beat struct {
+	text  string
+	pause time.Duration
+}
+
+// revealMsg asks the ceremony to reveal beat n.
+type revealMsg int
+
+// ceremonyModel plays a rose ceremony one name at a time. Enter skips ahead
+// to the next beat, and continues once every name has been called.
+type ceremonyModel struct {
+	title   string
+	beats   []beat
+	shown   int
+	aborted bool
+}
+
+func newCeremonyModel(ev game.Event) ceremonyModel {
+	cer := ev.Ceremony
+	var beats []beat
+	add := func(text string, pause time.Duration) {
+		beats = append(beats, beat{text, pause})
+	}
+
+	if cer.Intro != "" {
+		add(cer.Intro+"\n", 2*time.Second)
+	}
+	add(cer.Lead+" steps up to the mantel. The room goes quiet.\n", 2*time.Second)
+	for _, st := range cer.Safe {
+		add("🌹 "+game.HighlightStanding(st)+" is already safe with the "+st.Rose.String()+".", time.Second)
+	}
+	for _, st := range cer.Called {
+		if st.IsPlayer {
+			add("🌹 "+game.HighlightStanding(st)+", will you accept this rose? You step forward and take it.", 2*time.Second)
+		} else {
+			add("🌹 "+game.HighlightStanding(st), 1200*time.Millisecond)
+		}
+	}
+	if cer.FinalRose.Name != "" {
+		add("\nThere is one rose left.", 2*time.Second)
+		add(game.HighlightStanding(cer.FinalRose)+" and "+game.HighlightStanding(cer.FinalLoser)+" are still waiting.", 3*time.Second)
+		add(cer.Lead+" picks up the last rose and looks at them both for a long moment...", 3*time.Second)
+		add("🌹 "+game.HighlightStanding(cer.FinalRose)+".\n", 2*time.Second)
+	}
+	for _, e := range cer.Exits {
+		switch {
+		case e.IsPlayer:
+			add("❌ There's no rose for you tonight.", 2*time.Second)
+		case e.Quote != "":
+			add("❌ "+game.HighlightStanding(game.Standing{Name: e.Name, Status: game.StandingEliminated})+", in the limo: \""+e.Quote+"\"", 2500*time.Millisecond)
+		}
+	}
+	return ceremonyModel{title: ev.Title, beats: beats}
+}
+
+func (m ceremonyModel) done() bool {
+	return m.shown >= len(m.beats)
+}
+
+// after schedules beat n once the previous one has had its moment.
+func (m ceremonyModel) after(n int) tea.Cmd {
+	if n == 0 {
+		return func() tea.Msg { return revealMsg(0) }
+	}
+	return tea.Tick(m.beats[n-1].pause, func(time.Time) tea.Msg { return revealMsg(n) })
+}
+
+func (m ceremonyModel) Init() tea.Cmd {
+	return m.after(0)
+}
+
+func (m ceremonyModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case revealMsg:
+		// a tick for a beat that Enter already revealed is stale
+		if int(msg) != m.shown || m.done() {
+			return m, nil
+		}
+		m.shown++
+		if m.done() {
+			return m, nil
+		}
+		return m, m.after(m.shown)
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			m.aborted = true
+			return m, tea.Quit
+		case tea.KeyEnter, tea.KeySpace:
+			if m.done() {
+				return m, tea.Quit
+			}
+			m.shown++
+			if m.done() {
+				return m, nil
+			}
+			return m, m.after(m.shown)
+		}
+	}
+	return m, nil
+}
+
+func (m ceremonyModel) View() string {
+	var b strings.Builder
+	b.WriteString(ceremonyTitleStyle.Render(m.title) + "\n\n")
+	for _, bt := range m.beats[:m.shown] {
+		b.WriteString(ceremonyWrapStyle.Render(bt.text) + "\n")
+	}
+	if m.done() {
+		b.WriteString("\n" + ceremonyHintStyle.Render("↩︎ Press enter to continue") + "\n")
+	} else {
+		b.WriteString("\n" + ceremonyHintStyle.Render("(enter to skip ahead)") + "\n")
+	}
+	return b.String()
+}
+
+// PlayCeremony plays out a rose ceremony event in its own Bubble Tea
+// program.
+func PlayCeremony(ev game.Event) error {
+	final, err := tea.NewProgram(newCeremonyModel(ev)).Run()
+	if err != nil {
+		return err
+	}
+	if final.(ceremonyModel).aborted {
+		return huh.ErrUserAborted
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package ui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/huh"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/yourusername/bachelor-sim/game"
+)
+
+var speakerStyle = lipgloss.NewStyle().Bold(true)
+
+// typeDelay is how long each character of a line takes to appear.
+const typeDelay = 25 * time.Millisecond
+
+// typeMsg types out the next character of a line.
+type typeMsg struct{}
+
+// lineModel types out a line of dialogue a character at a time. Enter shows
+// the rest of the line, and continues once it is all there.
+type lineModel struct {
+	title   string
+	speaker string
+	text    []rune
+	shown   int
+	aborted bool
+}
+
+func (m lineModel) done() bool {
+	return m.shown >= len(m.text)
+}
+
+func (m lineModel) tick() tea.Cmd {
+	return tea.Tick(typeDelay, func(time.Time) tea.Msg { return typeMsg{} })
+}
+
+func (m lineModel) Init() tea.Cmd {
+	return m.tick()
+}
+
+func (m lineModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case typeMsg:
+		if m.done() {
+			return m, nil
+		}
+		m.shown++
+		return m, m.tick()
+	case tea.KeyMsg:
+		switch msg.Type {
+		case tea.KeyCtrlC:
+			m.aborted = true
+			return m, tea.Quit
+		case tea.KeyEnter, tea.KeySpace:
+			if m.done() {
+				return m, tea.Quit
+			}
+			m.shown = len(m.text)
+		}
+	}
+	return m, nil
+}
+
+func (m lineModel) View() string {
+	view := ceremonyTitleStyle.Render(m.title) + "\n\n" +
+		ceremonyWrapStyle.Render(speakerStyle.Render(m.speaker)+": "+string(m.text[:m.shown])) + "\n"
+	if m.done() {
+		return view + "\n" + ceremonyHintStyle.Render("↩︎ Press enter to continue") + "\n"
+	}
+	return view + "\n" + ceremonyHintStyle.Render("(enter to skip ahead)") + "\n"
+}
+
+// PlayLine types out a spoken line of dialogue in its own Bubble Tea
+// program.
+func PlayLine(ev game.Event) error {
+	final, err := tea.NewProgram(lineModel{
+		title:   ev.Title,
+		speaker: ev.Speaker,
+		text:    []rune(game.StripColors(ev.Text)),
+	}).Run()
+	if err != nil {
+		return err
+	}
+	if final.(lineModel).aborted {
+		return huh.ErrUserAborted
+	}
+	return nil
+}
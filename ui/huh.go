@@ -0,0 +1,99 @@
+// Package ui holds the terminal front-ends that render a game.Season.
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/huh"
+	"github.com/yourusername/bachelor-sim/game"
+)
+
+// Huh renders a season as a sequence of huh forms.
+type Huh struct{}
+
+func NewHuh() *Huh {
+	return &Huh{}
+}
+
+func ClearScreen() {
+	fmt.Print("\033[H\033[2J")
+}
+
+func (h *Huh) Show(ev game.Event) error {
+	if ev.Clear {
+		ClearScreen()
+	}
+	if ev.Ceremony != nil {
+		return PlayCeremony(ev)
+	}
+	if ev.Speaker != "" && len(ev.Standings) == 0 {
+		return PlayLine(ev)
+	}
+	desc := game.EventText(ev)
+
+	if ev.Phase == game.PhaseIntro {
+		return WaitForEnter(ev.Title, desc)
+	}
+	return huh.NewForm(
+		huh.NewGroup(
+			huh.NewNote().
+				Title(ev.Title).
+				Description(desc),
+		),
+	).Run()
+}
+
+func (h *Huh) Ask(p game.Prompt) (game.Answers, error) {
+	if p.Clear {
+		ClearScreen()
+	}
+
+	values := make([]string, len(p.Fields))
+	var fields []huh.Field
+	if p.Title != "" || p.Text != "" {
+		fields = append(fields, huh.NewNote().
+			Title(p.Title).
+			Description(p.Text))
+	}
+	for i, f := range p.Fields {
+		if len(f.Options) > 0 {
+			opts := make([]huh.Option[string], 0, len(f.Options))
+			for _, o := range f.Options {
+				opts = append(opts, huh.NewOption(o.Label, o.Value))
+			}
+			fields = append(fields, huh.NewSelect[string]().
+				Title(f.Title).
+				Options(opts...).
+				Value(&values[i]))
+		} else {
+			fields = append(fields, huh.NewInput().
+				Title(f.Title).
+				Placeholder(f.Placeholder).
+				Value(&values[i]))
+		}
+	}
+
+	if err := huh.NewForm(huh.NewGroup(fields...)).Run(); err != nil {
+		return nil, err
+	}
+
+	answers := game.Answers{}
+	for i, f := range p.Fields {
+		answers[f.Key] = values[i]
+	}
+	return answers, nil
+}
+
+func WaitForEnter(title string, desc string) error {
+	return huh.NewForm(
+		huh.NewGroup(
+			huh.NewNote().
+				Title(title).
+				Description(desc),
+
+			huh.NewInput().
+				Prompt("↩︎ Press enter to continue").
+				Value(new(string)),
+		),
+	).Run()
+}